@@ -0,0 +1,7 @@
+action "planetscale_oauth_token_revoke" "my_oauthtokenrevoke" {
+  config {
+    application_id = "...my_application_id..."
+    organization   = "...my_organization..."
+    token_id       = "...my_token_id..."
+  }
+}
\ No newline at end of file
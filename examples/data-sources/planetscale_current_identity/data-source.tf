@@ -0,0 +1,3 @@
+data "planetscale_current_identity" "my_currentidentity" {
+  organization = "...my_organization..."
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "planetscale_deploy_queue" "my_deployqueue" {
+  database     = "...my_database..."
+  organization = "...my_organization..."
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "planetscale_invoice" "my_invoice" {
+  id           = "...my_id..."
+  organization = "...my_organization..."
+}
\ No newline at end of file
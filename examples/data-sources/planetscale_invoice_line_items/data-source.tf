@@ -0,0 +1,4 @@
+data "planetscale_invoice_line_items" "my_invoice_line_items" {
+  id           = "...my_id..."
+  organization = "...my_organization..."
+}
\ No newline at end of file
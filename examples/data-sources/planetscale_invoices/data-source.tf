@@ -0,0 +1,3 @@
+data "planetscale_invoices" "my_invoices" {
+  organization = "...my_organization..."
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "planetscale_oauth_application" "my_oauthapplication" {
+  application_id = "...my_application_id..."
+  organization   = "...my_organization..."
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+data "planetscale_postgres_branch_restore_window" "my_postgresbranchrestorewindow" {
+  organization = "my-organization"
+  database     = "ru00w3vqvfr9"
+  branch       = "main"
+}
@@ -0,0 +1,5 @@
+data "planetscale_schema_recommendations" "my_schemarecommendations" {
+  database     = "...my_database..."
+  organization = "...my_organization..."
+  state        = "closed"
+}
\ No newline at end of file
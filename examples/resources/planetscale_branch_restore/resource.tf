@@ -0,0 +1,9 @@
+resource "planetscale_branch_restore" "my_branchrestore" {
+  organization = "my-organization"
+  database     = "ru00w3vqvfr9"
+  kind         = "mysql"
+  name         = "restore-drill"
+
+  backup_id     = "zwz4ahqq4lb9"
+  backup_branch = "main"
+}
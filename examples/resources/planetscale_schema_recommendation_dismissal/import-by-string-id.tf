@@ -0,0 +1,8 @@
+import {
+  to = planetscale_schema_recommendation_dismissal.my_planetscale_schema_recommendation_dismissal
+  id = jsonencode({
+    database     = "..."
+    number       = 42
+    organization = "..."
+  })
+}
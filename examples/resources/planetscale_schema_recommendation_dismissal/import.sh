@@ -0,0 +1 @@
+terraform import planetscale_schema_recommendation_dismissal.my_planetscale_schema_recommendation_dismissal '{"database": "...", "number": 42, "organization": "..."}'
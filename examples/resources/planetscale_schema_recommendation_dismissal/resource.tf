@@ -0,0 +1,7 @@
+resource "planetscale_schema_recommendation_dismissal" "my_schemarecommendationdismissal" {
+  organization = "my-organization"
+  database     = "ru00w3vqvfr9"
+  number       = 42
+
+  reason = "Index is kept for the nightly reporting job."
+}
\ No newline at end of file
@@ -4,6 +4,7 @@ resource "planetscale_vitess_branch" "my_vitessbranch" {
 
   name                          = "my-branch"
   deletion_protected            = true
+  production                    = true
   safe_migrations               = true
   vtgate_autoscaling            = true
   vtgate_count                  = 1
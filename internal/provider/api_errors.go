@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	sdkerrors "github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/errors"
+)
+
+// apiErrorBody is the error document returned by the PlanetScale API, such
+// as {"code": "not_found", "message": "Not Found"}. Validation failures may
+// also list field errors, either keyed by field or as a list.
+type apiErrorBody struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Errors  json.RawMessage `json:"errors"`
+}
+
+// apiFieldError is a validation error on a single request field.
+type apiFieldError struct {
+	Field   string
+	Message string
+}
+
+// attributeSchema is the schema of the plan, state or config an API call was
+// made for, such as req.Plan.Schema. Field errors are only reported on an
+// attribute when the schema has one of that name.
+type attributeSchema interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// hasAttribute reports whether s has a top level attribute called name.
+func hasAttribute(ctx context.Context, s attributeSchema, name string) bool {
+	if s == nil || name == "" {
+		return false
+	}
+
+	_, diags := s.TypeAtPath(ctx, path.Root(name))
+	return !diags.HasError()
+}
+
+// apiErrorHint is the summary and remediation shown for an error code.
+type apiErrorHint struct {
+	summary   string
+	hint      string
+	attribute string
+}
+
+var apiErrorHints = map[string]apiErrorHint{
+	"unauthorized": {
+		summary: "PlanetScale Authentication Failed",
+		hint:    "Check the provider credentials. Service tokens need both service_token and service_token_id, and OAuth access tokens expire; run pscale auth login again to refresh one.",
+	},
+	"forbidden": {
+		summary: "PlanetScale Access Denied",
+		hint:    "The credentials are valid but lack the access this operation needs. Grant the missing access to the service token in the organization settings, or check which accesses it has with the planetscale_current_identity data source.",
+	},
+	"not_found": {
+		summary: "PlanetScale Resource Not Found",
+		hint:    "Check the organization, database and branch names. The API also answers not found when the credentials cannot access the resource.",
+	},
+	"conflict": {
+		summary: "PlanetScale Resource Conflict",
+		hint:    "The resource already exists or another change is in progress. Wait for pending changes to finish, or import the existing resource.",
+	},
+	"unprocessable": {
+		summary: "PlanetScale Rejected the Request",
+	},
+	"rate_limited": {
+		summary: "PlanetScale API Rate Limit Exceeded",
+		hint:    "Lower the provider rate_limit settings or Terraform -parallelism, or raise retries.max_elapsed_time.",
+	},
+	"cluster_size_not_available": {
+		summary:   "Cluster Size Not Available",
+		hint:      "The requested cluster size is not offered for this database, region or plan. List the available sizes with pscale size cluster list, or in the database settings of the PlanetScale dashboard.",
+		attribute: "cluster_size",
+	},
+	"server_error": {
+		summary: "PlanetScale API Server Error",
+		hint:    "This is usually transient. Retry the operation; if it keeps failing, contact PlanetScale support with the time of the request.",
+	},
+}
+
+// apiErrorCodeFromStatus returns the error code used when a response does
+// not carry one.
+func apiErrorCodeFromStatus(statusCode int) string {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return "unauthorized"
+	case statusCode == http.StatusForbidden:
+		return "forbidden"
+	case statusCode == http.StatusNotFound:
+		return "not_found"
+	case statusCode == http.StatusConflict:
+		return "conflict"
+	case statusCode == http.StatusUnprocessableEntity:
+		return "unprocessable"
+	case statusCode == http.StatusTooManyRequests:
+		return "rate_limited"
+	case statusCode >= 500:
+		return "server_error"
+	}
+	return ""
+}
+
+// hintForAPIError looks up the hint for code, falling back to the status
+// code. Cluster size errors are recognised by their message as well, since
+// the API reports them with a generic validation code.
+func hintForAPIError(code string, message string, statusCode int) (apiErrorHint, bool) {
+	lowerMessage := strings.ToLower(message)
+	if strings.Contains(code, "cluster_size") || (strings.Contains(lowerMessage, "cluster size") && strings.Contains(lowerMessage, "not available")) {
+		return apiErrorHints["cluster_size_not_available"], true
+	}
+
+	if hint, ok := apiErrorHints[code]; ok {
+		return hint, true
+	}
+
+	hint, ok := apiErrorHints[apiErrorCodeFromStatus(statusCode)]
+	return hint, ok
+}
+
+// parseAPIErrorBody decodes an API error document. ok is false when the
+// body is not one.
+func parseAPIErrorBody(body []byte) (apiErrorBody, []apiFieldError, bool) {
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err != nil || (parsed.Code == "" && parsed.Message == "") {
+		return parsed, nil, false
+	}
+
+	return parsed, parseAPIFieldErrors(parsed.Errors), true
+}
+
+func parseAPIFieldErrors(raw json.RawMessage) []apiFieldError {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var fieldErrors []apiFieldError
+
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byField); err == nil {
+		for field, value := range byField {
+			var messages []string
+			if err := json.Unmarshal(value, &messages); err != nil {
+				var message string
+				if err := json.Unmarshal(value, &message); err != nil {
+					continue
+				}
+				messages = []string{message}
+			}
+			for _, message := range messages {
+				fieldErrors = append(fieldErrors, apiFieldError{Field: field, Message: message})
+			}
+		}
+		sort.SliceStable(fieldErrors, func(i, j int) bool { return fieldErrors[i].Field < fieldErrors[j].Field })
+		return fieldErrors
+	}
+
+	var list []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &list); err == nil {
+		for _, item := range list {
+			fieldErrors = append(fieldErrors, apiFieldError{Field: item.Field, Message: item.Message})
+		}
+	}
+
+	return fieldErrors
+}
+
+// apiResponseDiagnostics describes an unexpected API response. The error
+// document is decoded into a summary with a remediation hint, and field
+// errors are reported on the matching attribute of s, which may be nil. The
+// full request and response are only logged at debug level.
+func apiResponseDiagnostics(ctx context.Context, s attributeSchema, res *http.Response) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if res == nil {
+		diags.AddError("unexpected response from API", "The API returned no response.")
+		return diags
+	}
+
+	body := readResponseBody(res)
+
+	tflog.Debug(ctx, "Unexpected API response", map[string]interface{}{
+		"http_dump": debugResponse(res),
+	})
+
+	return append(diags, apiErrorDiagnostics(ctx, s, res.StatusCode, body)...)
+}
+
+// apiInvokeErrorDiagnostics describes an error returned by an SDK method.
+// API errors carry the response and are decoded like unexpected responses;
+// other errors, such as connection failures, are reported as is.
+func apiInvokeErrorDiagnostics(ctx context.Context, s attributeSchema, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiErr *sdkerrors.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError("failure to invoke API", redactedError(err))
+		return diags
+	}
+
+	if apiErr.RawResponse != nil {
+		tflog.Debug(ctx, "Unexpected API response", map[string]interface{}{
+			"http_dump": debugResponse(apiErr.RawResponse),
+		})
+	}
+
+	if _, _, ok := parseAPIErrorBody([]byte(apiErr.Body)); !ok && apiErr.StatusCode < 400 {
+		diags.AddError("failure to invoke API", redactedError(err))
+		return diags
+	}
+
+	return append(diags, apiErrorDiagnostics(ctx, s, apiErr.StatusCode, []byte(apiErr.Body))...)
+}
+
+func apiErrorDiagnostics(ctx context.Context, s attributeSchema, statusCode int, body []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parsed, fieldErrors, ok := parseAPIErrorBody(body)
+
+	code := parsed.Code
+	if code == "" {
+		code = apiErrorCodeFromStatus(statusCode)
+	}
+
+	message := parsed.Message
+	if !ok {
+		message = strings.TrimSpace(string(redactJSON(body)))
+	}
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+
+	summary := fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", statusCode)
+	hint, hasHint := hintForAPIError(code, message, statusCode)
+	if hasHint {
+		summary = fmt.Sprintf("%s (HTTP %d)", hint.summary, statusCode)
+	}
+
+	detail := message
+	if code != "" {
+		detail = fmt.Sprintf("%s (code: %s)", message, code)
+	}
+	if hasHint && hint.hint != "" {
+		detail += "\n\n" + hint.hint
+	}
+
+	switch {
+	case len(fieldErrors) > 0:
+		for _, fieldError := range fieldErrors {
+			fieldDetail := fmt.Sprintf("%s %s\n\n%s", fieldError.Field, fieldError.Message, detail)
+			if !hasAttribute(ctx, s, fieldError.Field) {
+				diags.AddError(summary, fieldDetail)
+				continue
+			}
+			diags.Append(diag.NewAttributeErrorDiagnostic(path.Root(fieldError.Field), summary, fieldDetail))
+		}
+	case hasHint && hasAttribute(ctx, s, hint.attribute):
+		diags.Append(diag.NewAttributeErrorDiagnostic(path.Root(hint.attribute), summary, detail))
+	default:
+		diags.AddError(summary, detail)
+	}
+
+	return diags
+}
+
+// readResponseBody returns the response body, leaving it readable for any
+// later consumer. Error responses already drained by the SDK are read from
+// the copy it keeps.
+func readResponseBody(res *http.Response) []byte {
+	if body := sdk.ErrorResponseBody(res); body != nil {
+		return body
+	}
+
+	if res.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	return body
+}
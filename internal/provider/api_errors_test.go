@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkerrors "github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func testAPIResponse(statusCode int, body string) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.planetscale.com/v1/organizations/acme", nil)
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// testAttributeSchema is a resource schema with the attributes the API
+// error tests name in field errors.
+var testAttributeSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name":         schema.StringAttribute{Required: true},
+		"cluster_size": schema.StringAttribute{Optional: true},
+	},
+}
+
+func TestAPIResponseDiagnosticsNotFound(t *testing.T) {
+	t.Parallel()
+
+	res := testAPIResponse(http.StatusNotFound, `{"code":"not_found","message":"Not Found"}`)
+	diags := apiResponseDiagnostics(context.Background(), nil, res)
+
+	require.Len(t, diags, 1)
+	require.Equal(t, "PlanetScale Resource Not Found (HTTP 404)", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "Not Found (code: not_found)")
+	require.Contains(t, diags[0].Detail(), "Check the organization, database and branch names.")
+	require.NotContains(t, diags[0].Detail(), "HTTP/1.1")
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"code":"not_found","message":"Not Found"}`, string(body))
+}
+
+func TestAPIResponseDiagnosticsCodeFromStatus(t *testing.T) {
+	t.Parallel()
+
+	diags := apiResponseDiagnostics(context.Background(), nil, testAPIResponse(http.StatusForbidden, ``))
+
+	require.Len(t, diags, 1)
+	require.Equal(t, "PlanetScale Access Denied (HTTP 403)", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "Forbidden (code: forbidden)")
+}
+
+func TestAPIResponseDiagnosticsFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"keyed":  `{"code":"unprocessable","message":"Validation failed","errors":{"name":["is too long","is invalid"]}}`,
+		"listed": `{"code":"unprocessable","message":"Validation failed","errors":[{"field":"name","message":"is too long"},{"field":"name","message":"is invalid"}]}`,
+	}
+
+	for name, body := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := apiResponseDiagnostics(context.Background(), testAttributeSchema, testAPIResponse(http.StatusUnprocessableEntity, body))
+
+			require.Len(t, diags, 2)
+			for _, d := range diags {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				require.True(t, ok)
+				require.Equal(t, path.Root("name"), withPath.Path())
+				require.Equal(t, "PlanetScale Rejected the Request (HTTP 422)", d.Summary())
+			}
+			require.Contains(t, diags[0].Detail(), "name is too long")
+			require.Contains(t, diags[1].Detail(), "name is invalid")
+		})
+	}
+}
+
+func TestAPIResponseDiagnosticsUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	body := `{"code":"unprocessable","message":"Validation failed","errors":{"clusterSize":["is invalid"],"parent.name":["is taken"]}}`
+	diags := apiResponseDiagnostics(context.Background(), testAttributeSchema, testAPIResponse(http.StatusUnprocessableEntity, body))
+
+	require.Len(t, diags, 2)
+	for _, d := range diags {
+		_, ok := d.(diag.DiagnosticWithPath)
+		require.False(t, ok, d.Detail())
+	}
+	require.Contains(t, diags[0].Detail(), "clusterSize is invalid")
+	require.Contains(t, diags[1].Detail(), "parent.name is taken")
+
+	body = `{"code":"unprocessable","message":"Cluster size PS_10 is not available in this region"}`
+	diags = apiResponseDiagnostics(context.Background(), nil, testAPIResponse(http.StatusUnprocessableEntity, body))
+
+	require.Len(t, diags, 1)
+	_, ok := diags[0].(diag.DiagnosticWithPath)
+	require.False(t, ok)
+	require.Equal(t, "Cluster Size Not Available (HTTP 422)", diags[0].Summary())
+}
+
+func TestAPIResponseDiagnosticsClusterSizeNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	body := `{"code":"unprocessable","message":"Cluster size PS_10 is not available in this region"}`
+	diags := apiResponseDiagnostics(context.Background(), testAttributeSchema, testAPIResponse(http.StatusUnprocessableEntity, body))
+
+	require.Len(t, diags, 1)
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	require.Equal(t, path.Root("cluster_size"), withPath.Path())
+	require.Equal(t, "Cluster Size Not Available (HTTP 422)", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "pscale size cluster list")
+}
+
+func TestAPIInvokeErrorDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	body := `{"code":"rate_limited","message":"Too many requests"}`
+	apiErr := sdkerrors.NewAPIError("API error occurred", http.StatusTooManyRequests, body, testAPIResponse(http.StatusTooManyRequests, body))
+
+	diags := apiInvokeErrorDiagnostics(context.Background(), nil, fmt.Errorf("listing branches: %w", apiErr))
+	require.Len(t, diags, 1)
+	require.Equal(t, "PlanetScale API Rate Limit Exceeded (HTTP 429)", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "rate_limit")
+
+	diags = apiInvokeErrorDiagnostics(context.Background(), nil, errors.New("dial tcp: connection refused"))
+	require.Len(t, diags, 1)
+	require.Equal(t, "failure to invoke API", diags[0].Summary())
+	require.Equal(t, "dial tcp: connection refused", diags[0].Detail())
+}
@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigValidators = &VitessBackupPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &PostgresBackupPolicyResource{}
+)
+
+// backupPolicyNextRunsCount is the number of upcoming runs listed in next_runs.
+const backupPolicyNextRunsCount = 5
+
+// backupPolicySchedule is a fully known backup policy schedule. All times are
+// in UTC.
+type backupPolicySchedule struct {
+	frequencyUnit  string
+	frequencyValue int64
+	scheduleDay    int64
+	scheduleWeek   int64
+	hour, minute   int
+}
+
+// backupPolicyScheduleAttributes holds the attributes a schedule is derived
+// from, as they appear in a plan, state or model.
+type backupPolicyScheduleAttributes struct {
+	FrequencyUnit  types.String
+	FrequencyValue types.Int64
+	ScheduleDay    types.Int64
+	ScheduleTime   types.String
+	ScheduleWeek   types.Int64
+}
+
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+func getBackupPolicyScheduleAttributes(ctx context.Context, src attributeGetter) (backupPolicyScheduleAttributes, diag.Diagnostics) {
+	var attrs backupPolicyScheduleAttributes
+	var diags diag.Diagnostics
+
+	diags.Append(src.GetAttribute(ctx, path.Root("frequency_unit"), &attrs.FrequencyUnit)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("frequency_value"), &attrs.FrequencyValue)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("schedule_day"), &attrs.ScheduleDay)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("schedule_time"), &attrs.ScheduleTime)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("schedule_week"), &attrs.ScheduleWeek)...)
+	return attrs, diags
+}
+
+func (a backupPolicyScheduleAttributes) equal(b backupPolicyScheduleAttributes) bool {
+	return a.FrequencyUnit.Equal(b.FrequencyUnit) &&
+		a.FrequencyValue.Equal(b.FrequencyValue) &&
+		a.ScheduleDay.Equal(b.ScheduleDay) &&
+		a.ScheduleTime.Equal(b.ScheduleTime) &&
+		a.ScheduleWeek.Equal(b.ScheduleWeek)
+}
+
+// schedule returns the schedule described by the attributes. known is false
+// when an attribute the schedule needs is unknown, and ok is false when the
+// attributes do not describe a valid schedule.
+func (a backupPolicyScheduleAttributes) schedule() (s backupPolicySchedule, known bool, ok bool) {
+	for _, v := range []attr.Value{a.FrequencyUnit, a.FrequencyValue, a.ScheduleDay, a.ScheduleTime, a.ScheduleWeek} {
+		if v.IsUnknown() {
+			return s, false, false
+		}
+	}
+	if a.FrequencyUnit.IsNull() || a.FrequencyValue.IsNull() || a.ScheduleTime.IsNull() {
+		return s, true, false
+	}
+
+	at, err := time.Parse("15:04", a.ScheduleTime.ValueString())
+	if err != nil {
+		return s, true, false
+	}
+	s = backupPolicySchedule{
+		frequencyUnit:  a.FrequencyUnit.ValueString(),
+		frequencyValue: a.FrequencyValue.ValueInt64(),
+		scheduleDay:    a.ScheduleDay.ValueInt64(),
+		scheduleWeek:   a.ScheduleWeek.ValueInt64(),
+		hour:           at.Hour(),
+		minute:         at.Minute(),
+	}
+	if s.frequencyValue < 1 {
+		return s, true, false
+	}
+
+	switch s.frequencyUnit {
+	case "hour", "day":
+		return s, true, true
+	case "week":
+		return s, true, !a.ScheduleDay.IsNull()
+	case "month":
+		return s, true, !a.ScheduleDay.IsNull() && !a.ScheduleWeek.IsNull()
+	default:
+		return s, true, false
+	}
+}
+
+// nextRuns returns the next count runs of the schedule strictly after after.
+//
+// Runs with a frequency_value above one are anchored to the Unix epoch, so
+// "every 6 hours at 00:15" runs at 00:15, 06:15, 12:15 and 18:15 UTC, and
+// "every 2 months" runs in even-numbered months since January 1970.
+func (s backupPolicySchedule) nextRuns(after time.Time, count int) []time.Time {
+	after = after.UTC()
+	runs := make([]time.Time, 0, count)
+
+	if s.frequencyUnit == "month" {
+		year, month, _ := after.Date()
+		for len(runs) < count {
+			index := int64(year-1970)*12 + int64(month-1)
+			if index%s.frequencyValue == 0 {
+				if run := s.monthlyRun(year, month); run.After(after) {
+					runs = append(runs, run)
+				}
+			}
+			month++
+			if month > time.December {
+				month = time.January
+				year++
+			}
+		}
+		return runs
+	}
+
+	var period time.Duration
+	first := time.Date(1970, time.January, 1, s.hour, s.minute, 0, 0, time.UTC)
+	switch s.frequencyUnit {
+	case "hour":
+		period = time.Duration(s.frequencyValue) * time.Hour
+	case "day":
+		period = time.Duration(s.frequencyValue) * 24 * time.Hour
+	case "week":
+		period = time.Duration(s.frequencyValue) * 7 * 24 * time.Hour
+		first = first.AddDate(0, 0, int(s.scheduleDay-int64(first.Weekday())+7)%7)
+	}
+
+	run := first
+	if after.After(first) || after.Equal(first) {
+		run = first.Add((after.Sub(first)/period + 1) * period)
+	}
+	for len(runs) < count {
+		runs = append(runs, run)
+		run = run.Add(period)
+	}
+	return runs
+}
+
+// monthlyRun returns the run in the given month: the schedule_day weekday of
+// the schedule_week week, counting from the first such weekday of the month.
+func (s backupPolicySchedule) monthlyRun(year int, month time.Month) time.Time {
+	first := time.Date(year, month, 1, s.hour, s.minute, 0, 0, time.UTC)
+	offset := int(s.scheduleDay-int64(first.Weekday())+7) % 7
+	return first.AddDate(0, 0, offset+int(s.scheduleWeek)*7)
+}
+
+// backupPolicyNextRuns returns next_run_at and next_runs for the schedule.
+// Both are unknown when the schedule is not yet known, and null when it is
+// incomplete.
+func backupPolicyNextRuns(attrs backupPolicyScheduleAttributes, now time.Time) (types.String, types.List) {
+	s, known, ok := attrs.schedule()
+	switch {
+	case !known:
+		return types.StringUnknown(), types.ListUnknown(types.StringType)
+	case !ok:
+		return types.StringNull(), types.ListNull(types.StringType)
+	}
+
+	runs := s.nextRuns(now, backupPolicyNextRunsCount)
+	values := make([]attr.Value, 0, len(runs))
+	for _, run := range runs {
+		values = append(values, types.StringValue(run.Format(time.RFC3339)))
+	}
+	return values[0].(types.String), types.ListValueMust(types.StringType, values)
+}
+
+// modifyBackupPolicyPlan fills next_run_at and next_runs in the plan. They are
+// recomputed when the resource is created or its schedule changes, and kept
+// from state otherwise so unrelated changes do not show them as changing.
+func modifyBackupPolicyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planned, diags := getBackupPolicyScheduleAttributes(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nextRunAt, nextRuns := backupPolicyNextRuns(planned, time.Now())
+	if !req.State.Raw.IsNull() {
+		current, diags := getBackupPolicyScheduleAttributes(ctx, req.State)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planned.equal(current) {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("next_run_at"), &nextRunAt)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("next_runs"), &nextRuns)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run_at"), nextRunAt)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), nextRuns)...)
+}
+
+// nextRunsPlanned reports whether the plan already holds next_run_at, in
+// which case the applied value must match it.
+func nextRunsPlanned(plan types.Object) bool {
+	v, ok := plan.Attributes()["next_run_at"]
+	return ok && !v.IsUnknown()
+}
+
+func (r *VitessBackupPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.BackupPolicySchedule(),
+	}
+}
+
+func (m *VitessBackupPolicyResourceModel) refreshNextRuns(now time.Time) {
+	m.NextRunAt, m.NextRuns = backupPolicyNextRuns(backupPolicyScheduleAttributes{
+		FrequencyUnit:  m.FrequencyUnit,
+		FrequencyValue: m.FrequencyValue,
+		ScheduleDay:    m.ScheduleDay,
+		ScheduleTime:   m.ScheduleTime,
+		ScheduleWeek:   m.ScheduleWeek,
+	}, now)
+	if m.NextRunAt.IsUnknown() {
+		// The API has answered by now, so anything still unknown was not set.
+		m.NextRunAt, m.NextRuns = types.StringNull(), types.ListNull(types.StringType)
+	}
+}
+
+func (r *PostgresBackupPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.BackupPolicySchedule(),
+	}
+}
+
+func (m *PostgresBackupPolicyResourceModel) refreshNextRuns(now time.Time) {
+	m.NextRunAt, m.NextRuns = backupPolicyNextRuns(backupPolicyScheduleAttributes{
+		FrequencyUnit:  m.FrequencyUnit,
+		FrequencyValue: m.FrequencyValue,
+		ScheduleDay:    m.ScheduleDay,
+		ScheduleTime:   m.ScheduleTime,
+		ScheduleWeek:   m.ScheduleWeek,
+	}, now)
+	if m.NextRunAt.IsUnknown() {
+		m.NextRunAt, m.NextRuns = types.StringNull(), types.ListNull(types.StringType)
+	}
+}
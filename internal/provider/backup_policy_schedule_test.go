@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupPolicyNextRuns(t *testing.T) {
+	t.Parallel()
+
+	// A Tuesday.
+	now := time.Date(2026, 3, 10, 12, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		attrs backupPolicyScheduleAttributes
+		want  []string
+	}{
+		"every 6 hours": {
+			attrs: backupPolicyScheduleAttributes{
+				FrequencyUnit:  types.StringValue("hour"),
+				FrequencyValue: types.Int64Value(6),
+				ScheduleDay:    types.Int64Null(),
+				ScheduleTime:   types.StringValue("00:15"),
+				ScheduleWeek:   types.Int64Null(),
+			},
+			want: []string{"2026-03-10T18:15:00Z", "2026-03-11T00:15:00Z", "2026-03-11T06:15:00Z", "2026-03-11T12:15:00Z", "2026-03-11T18:15:00Z"},
+		},
+		"daily": {
+			attrs: backupPolicyScheduleAttributes{
+				FrequencyUnit:  types.StringValue("day"),
+				FrequencyValue: types.Int64Value(1),
+				ScheduleDay:    types.Int64Null(),
+				ScheduleTime:   types.StringValue("12:30"),
+				ScheduleWeek:   types.Int64Null(),
+			},
+			want: []string{"2026-03-11T12:30:00Z", "2026-03-12T12:30:00Z", "2026-03-13T12:30:00Z", "2026-03-14T12:30:00Z", "2026-03-15T12:30:00Z"},
+		},
+		"weekly on sunday": {
+			attrs: backupPolicyScheduleAttributes{
+				FrequencyUnit:  types.StringValue("week"),
+				FrequencyValue: types.Int64Value(1),
+				ScheduleDay:    types.Int64Value(0),
+				ScheduleTime:   types.StringValue("03:00"),
+				ScheduleWeek:   types.Int64Null(),
+			},
+			want: []string{"2026-03-15T03:00:00Z", "2026-03-22T03:00:00Z", "2026-03-29T03:00:00Z", "2026-04-05T03:00:00Z", "2026-04-12T03:00:00Z"},
+		},
+		"monthly on the second monday": {
+			attrs: backupPolicyScheduleAttributes{
+				FrequencyUnit:  types.StringValue("month"),
+				FrequencyValue: types.Int64Value(1),
+				ScheduleDay:    types.Int64Value(1),
+				ScheduleTime:   types.StringValue("01:00"),
+				ScheduleWeek:   types.Int64Value(1),
+			},
+			want: []string{"2026-04-13T01:00:00Z", "2026-05-11T01:00:00Z", "2026-06-08T01:00:00Z", "2026-07-13T01:00:00Z", "2026-08-10T01:00:00Z"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			nextRunAt, nextRuns := backupPolicyNextRuns(tt.attrs, now)
+			var got []string
+			for _, v := range nextRuns.Elements() {
+				got = append(got, v.(types.String).ValueString())
+			}
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want[0], nextRunAt.ValueString())
+		})
+	}
+
+	nextRunAt, _ := backupPolicyNextRuns(backupPolicyScheduleAttributes{
+		FrequencyUnit:  types.StringValue("week"),
+		FrequencyValue: types.Int64Value(1),
+		ScheduleDay:    types.Int64Unknown(),
+		ScheduleTime:   types.StringValue("03:00"),
+		ScheduleWeek:   types.Int64Null(),
+	}, now)
+	require.True(t, nextRunAt.IsUnknown())
+
+	nextRunAt, _ = backupPolicyNextRuns(backupPolicyScheduleAttributes{
+		FrequencyUnit:  types.StringValue("week"),
+		FrequencyValue: types.Int64Value(1),
+		ScheduleDay:    types.Int64Null(),
+		ScheduleTime:   types.StringValue("03:00"),
+		ScheduleWeek:   types.Int64Null(),
+	}, now)
+	require.True(t, nextRunAt.IsNull())
+}
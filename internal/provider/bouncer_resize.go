@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+)
+
+// bouncerResizePollingConfig follows the keyspace resize schedule. Bouncer
+// resizes only have list endpoints, so the schemas have no polling
+// configuration for them.
+func bouncerResizePollingConfig(opts ...polling.Option) (*polling.Config, error) {
+	delaySeconds := 0
+	intervalSeconds := 5
+	limitCount := 720 // 1 hour at a 5 second interval
+
+	config := &polling.Config{
+		Name:            "WaitForBouncerResizeComplete",
+		DelaySeconds:    &delaySeconds,
+		IntervalSeconds: &intervalSeconds,
+		LimitCount:      &limitCount,
+	}
+
+	for _, opt := range opts {
+		if err := opt(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// bouncerResize is the part of a bouncer resize request the provider waits
+// on. The bouncer and branch resize lists return the same object.
+type bouncerResize struct {
+	state           string
+	sku             string
+	previousSku     string
+	replicasPerCell int64
+}
+
+// bouncerResizeNotFoundLimit is the number of consecutive polls a submitted
+// resize request may be missing from the resize lists before waiting gives
+// up. The lists can lag behind the update that created the request.
+const bouncerResizeNotFoundLimit = 5
+
+// waitForBouncerResize waits until the resize request submitted by an apply
+// reaches a terminal state, so the new pooler capacity is live before the
+// apply finishes. A resize that is canceled, fails or cannot be found is
+// reported as an error.
+func (r *PostgresBouncerResource) waitForBouncerResize(ctx context.Context, data *PostgresBouncerResourceModel, opts ...polling.Option) (diags diag.Diagnostics) {
+	resizeID := data.ResizeRequestID.ValueString()
+	if resizeID == "" || data.ResizeRequestState.ValueString() == "completed" {
+		return diags
+	}
+
+	progress := newWaitProgress("bouncer resize to complete", typicalBranchResizeDuration)
+	defer func() {
+		diags = append(progress.Diagnostics(), diags...)
+	}()
+
+	config, err := bouncerResizePollingConfig(append([]polling.Option{withPollingBackoff, polling.WithAttemptHook(progress.Observe)}, opts...)...)
+	if err != nil {
+		diags.AddError("Invalid Polling Configuration", err.Error())
+		return diags
+	}
+
+	poller := polling.NewPoller(config)
+	notFound := 0
+	for {
+		resize, resizeDiags := r.findBouncerResize(ctx, data, resizeID)
+		diags.Append(resizeDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		state := "not_found"
+		if resize != nil {
+			state = resize.state
+			notFound = 0
+		} else {
+			notFound++
+		}
+		poller.Observe(ctx, 200, state)
+
+		switch {
+		case resize == nil:
+			if notFound >= bouncerResizeNotFoundLimit {
+				diags.AddError(
+					"Bouncer Resize Not Found",
+					fmt.Sprintf("Resize request %s of bouncer %q was not listed by the API after %d attempts. "+
+						"Check the bouncer in the PlanetScale dashboard, then apply again.",
+						resizeID, data.Name.ValueString(), notFound),
+				)
+				return diags
+			}
+		case state == "completed":
+			return diags
+		case state == "canceled":
+			diags.AddError(
+				"Bouncer Resize Canceled",
+				fmt.Sprintf("Resize request %s of bouncer %q was canceled before it completed, so the bouncer still runs %s "+
+					"instead of %s with %d replica(s) per cell. Check the bouncer in the PlanetScale dashboard, then apply again.",
+					resizeID, data.Name.ValueString(), resize.previousSku, resize.sku, resize.replicasPerCell),
+			)
+			return diags
+		case !isInFlightResizeState(state):
+			diags.AddError(
+				"Bouncer Resize Failed",
+				fmt.Sprintf("Resize request %s of bouncer %q from %s to %s with %d replica(s) per cell stopped in state %q. "+
+					"Check the bouncer in the PlanetScale dashboard, then apply again.",
+					resizeID, data.Name.ValueString(), resize.previousSku, resize.sku, resize.replicasPerCell, state),
+			)
+			return diags
+		}
+
+		if err := poller.Wait(ctx); err != nil {
+			diags.AddError(
+				"Bouncer Resize Did Not Complete",
+				fmt.Sprintf("Waiting for resize request %s of bouncer %q: %s", resizeID, data.Name.ValueString(), err),
+			)
+			return diags
+		}
+	}
+}
+
+// findBouncerResize looks the resize request up in the bouncer's resizes and,
+// if it is not listed there, in the branch's bouncer resizes. It returns nil
+// when neither list contains it yet.
+func (r *PostgresBouncerResource) findBouncerResize(ctx context.Context, data *PostgresBouncerResourceModel, resizeID string) (*bouncerResize, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := r.client.BouncerResizes.ListBouncerResizeRequests(ctx, operations.ListBouncerResizeRequestsRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Branch.ValueString(),
+		Bouncer:      data.Name.ValueString(),
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return nil, diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return nil, diags
+	}
+	if res.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return nil, diags
+	}
+	if res.Object != nil {
+		for _, resize := range res.Object.Data {
+			if resize.ID == resizeID {
+				return &bouncerResize{
+					state:           resize.State,
+					sku:             resize.Sku.Name,
+					previousSku:     resize.PreviousSku.Name,
+					replicasPerCell: resize.ReplicasPerCell,
+				}, diags
+			}
+		}
+	}
+
+	branchRes, err := r.client.APIBranchBouncerResizes.ListBranchBouncerResizeRequests(ctx, operations.ListBranchBouncerResizeRequestsRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Branch.ValueString(),
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return nil, diags
+	}
+	if branchRes == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", branchRes))
+		return nil, diags
+	}
+	if branchRes.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, branchRes.RawResponse)...)
+		return nil, diags
+	}
+	if branchRes.Object != nil {
+		for _, resize := range branchRes.Object.Data {
+			if resize.ID == resizeID {
+				return &bouncerResize{
+					state:           resize.State,
+					sku:             resize.Sku.Name,
+					previousSku:     resize.PreviousSku.Name,
+					replicasPerCell: resize.ReplicasPerCell,
+				}, diags
+			}
+		}
+	}
+
+	return nil, diags
+}
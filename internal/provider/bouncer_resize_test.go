@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForBouncerResize(t *testing.T) {
+	t.Parallel()
+
+	const (
+		bouncerResizesPath = "/organizations/org/databases/db/branches/main/bouncers/pool/resizes"
+		branchResizesPath  = "/organizations/org/databases/db/branches/main/bouncer-resizes"
+	)
+
+	resizeList := func(state string) string {
+		data := "[]"
+		if state != "" {
+			data = `[{
+				"id": "resize-1",
+				"state": "` + state + `",
+				"replicas_per_cell": 2,
+				"sku": {"name": "PGB_10"},
+				"previous_sku": {"name": "PGB_5"}
+			}]`
+		}
+		return `{"type": "list", "current_page": 1, "per_page": 25, "data": ` + data + `}`
+	}
+
+	testCases := map[string]struct {
+		bouncerState string
+		branchState  string
+		branchStatus int
+		wantCalls    int32
+		wantError    string
+	}{
+		"completed": {
+			bouncerState: "completed",
+			wantCalls:    1,
+		},
+		"listed by the branch only": {
+			branchState: "completed",
+			wantCalls:   2,
+		},
+		"canceled": {
+			bouncerState: "canceled",
+			wantCalls:    1,
+			wantError:    "still runs PGB_5 instead of PGB_10",
+		},
+		"failed": {
+			bouncerState: "failed",
+			wantCalls:    1,
+			wantError:    `stopped in state "failed"`,
+		},
+		"never listed": {
+			wantCalls: 2 * bouncerResizeNotFoundLimit,
+			wantError: fmt.Sprintf("was not listed by the API after %d attempts", bouncerResizeNotFoundLimit),
+		},
+		"branch list error": {
+			branchStatus: http.StatusInternalServerError,
+			wantCalls:    2,
+			wantError:    "500",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+				calls.Add(1)
+				switch r.URL.Path {
+				case bouncerResizesPath:
+					writeJSON(w, resizeList(tc.bouncerState))
+				case branchResizesPath:
+					if tc.branchStatus != 0 {
+						w.WriteHeader(tc.branchStatus)
+						return nil
+					}
+					writeJSON(w, resizeList(tc.branchState))
+				default:
+					return errors.New("expected a resize list path")
+				}
+				return nil
+			})
+
+			data := &PostgresBouncerResourceModel{
+				Organization:       types.StringValue("org"),
+				Database:           types.StringValue("db"),
+				Branch:             types.StringValue("main"),
+				Name:               types.StringValue("pool"),
+				ResizeRequestID:    types.StringValue("resize-1"),
+				ResizeRequestState: types.StringValue("pending"),
+			}
+
+			r := &PostgresBouncerResource{client: client}
+			diags := r.waitForBouncerResize(context.Background(), data, polling.WithIntervalSecondsOverride(0))
+
+			require.Equal(t, tc.wantCalls, calls.Load())
+			if tc.wantError == "" {
+				require.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			require.Contains(t, diags.Errors()[0].Summary()+" "+diags.Errors()[0].Detail(), tc.wantError)
+		})
+	}
+}
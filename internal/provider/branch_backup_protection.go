@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+)
+
+// protectedBackupDeleteError explains how to delete a protected backup. The
+// force_delete value that counts is the one in state, so it has to be applied
+// before the destroy.
+func protectedBackupDeleteError(id, branch types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Backup Is Protected",
+		fmt.Sprintf("Backup %s of branch %q is protected from deletion. Set protected = false, or set force_delete = true, "+
+			"and apply before destroying it.", id.ValueString(), branch.ValueString()),
+	)
+	return diags
+}
+
+// unprotectForDelete refuses to delete a protected backup unless force_delete
+// is set, in which case protection is removed so the delete can proceed.
+func (r *VitessBranchBackupResource) unprotectForDelete(ctx context.Context, data *VitessBranchBackupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.Protected.ValueBool() {
+		return diags
+	}
+	if !data.ForceDelete.ValueBool() {
+		return protectedBackupDeleteError(data.ID, data.Branch)
+	}
+
+	unprotected := false
+	tflog.Info(ctx, "Removing backup protection before forced delete", map[string]interface{}{
+		"backup_id": data.ID.ValueString(),
+	})
+
+	res, err := r.client.Backups.UpdateVitessBranchBackup(ctx, operations.UpdateVitessBranchBackupRequest{
+		ID:           data.ID.ValueString(),
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Branch.ValueString(),
+		Body:         &operations.UpdateVitessBranchBackupRequestBody{Protected: &unprotected},
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	switch res.StatusCode {
+	case 200, 404:
+	default:
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+	}
+
+	return diags
+}
+
+// unprotectForDelete refuses to delete a protected backup unless force_delete
+// is set, in which case protection is removed so the delete can proceed.
+func (r *PostgresBranchBackupResource) unprotectForDelete(ctx context.Context, data *PostgresBranchBackupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.Protected.ValueBool() {
+		return diags
+	}
+	if !data.ForceDelete.ValueBool() {
+		return protectedBackupDeleteError(data.ID, data.Branch)
+	}
+
+	unprotected := false
+	tflog.Info(ctx, "Removing backup protection before forced delete", map[string]interface{}{
+		"backup_id": data.ID.ValueString(),
+	})
+
+	res, err := r.client.Backups.UpdatePostgresBranchBackup(ctx, operations.UpdatePostgresBranchBackupRequest{
+		ID:           data.ID.ValueString(),
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Branch.ValueString(),
+		Body:         &operations.UpdatePostgresBranchBackupRequestBody{Protected: &unprotected},
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	switch res.StatusCode {
+	case 200, 404:
+	default:
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+	}
+
+	return diags
+}
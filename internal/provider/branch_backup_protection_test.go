@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnprotectForDelete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		patched []string
+	)
+	r := &VitessBranchBackupResource{
+		client: newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+			if err := expectRequest(r, http.MethodPatch, "/organizations/org/databases/db/branches/main/backups/backup-1"); err != nil {
+				return err
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			patched = append(patched, string(body))
+			mu.Unlock()
+
+			writeJSON(w, `{"id": "backup-1", "name": "nightly", "state": "success", "protected": false}`)
+			return nil
+		}),
+	}
+	newData := func(protected, forceDelete bool) *VitessBranchBackupResourceModel {
+		return &VitessBranchBackupResourceModel{
+			ID:           types.StringValue("backup-1"),
+			Organization: types.StringValue("org"),
+			Database:     types.StringValue("db"),
+			Branch:       types.StringValue("main"),
+			Protected:    types.BoolValue(protected),
+			ForceDelete:  types.BoolValue(forceDelete),
+		}
+	}
+
+	diags := r.unprotectForDelete(context.Background(), newData(false, false))
+	require.False(t, diags.HasError(), diags)
+	require.Empty(t, patched)
+
+	diags = r.unprotectForDelete(context.Background(), newData(true, false))
+	require.True(t, diags.HasError())
+	require.Equal(t, "Backup Is Protected", diags.Errors()[0].Summary())
+	require.Empty(t, patched)
+
+	diags = r.unprotectForDelete(context.Background(), newData(true, true))
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, []string{`{"protected":false}`}, patched)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+)
+
+// errNoDefaultKeyspace is returned when a branch lists no default keyspace.
+var errNoDefaultKeyspace = errors.New("the branch has no default keyspace")
+
+// plannedClusterSizeChange reports whether the planned cluster_size differs
+// from the current one. A null or unknown plan leaves the size as it is.
+func plannedClusterSizeChange(planned, current types.String) bool {
+	if planned.IsNull() || planned.IsUnknown() {
+		return false
+	}
+	return planned.ValueString() != current.ValueString()
+}
+
+// defaultKeyspace returns the name of the default keyspace of a branch, which
+// is the keyspace the branch cluster_size applies to.
+func defaultKeyspace(ctx context.Context, client *sdk.PlanetScale, organization, database, branch string) (string, error) {
+	res, err := client.DatabaseBranchKeyspaces.ListKeyspaces(ctx, operations.ListKeyspacesRequest{
+		Organization: organization,
+		Database:     database,
+		Branch:       branch,
+	})
+
+	for {
+		if err != nil {
+			return "", err
+		}
+		if res == nil {
+			break
+		}
+		if res.StatusCode != 200 || res.Object == nil {
+			return "", fmt.Errorf("unexpected response listing keyspaces of branch %q: %s", branch, res.RawResponse.Status)
+		}
+
+		for _, keyspace := range res.Object.Data {
+			if keyspace.IsDefault {
+				return keyspace.Name, nil
+			}
+		}
+
+		res, err = res.Next()
+	}
+
+	return "", errNoDefaultKeyspace
+}
+
+// resizeDefaultKeyspace applies a changed cluster_size in place. Branches
+// have no resize of their own for it, so the default keyspace is resized
+// and followed until the resize completes, as planetscale_vitess_keyspace
+// does.
+func (r *VitessBranchResource) resizeDefaultKeyspace(ctx context.Context, data *VitessBranchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keyspace, err := defaultKeyspace(ctx, r.client, data.Organization.ValueString(), data.Database.ValueString(), data.Name.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Resize Branch",
+			fmt.Sprintf("Looking up the default keyspace of branch %q to change its cluster size failed: %s", data.Name.ValueString(), redactedError(err)),
+		)
+		return diags
+	}
+
+	res, err := r.client.KeyspaceResizes.UpdateKeyspaceResizeRequest(ctx, operations.UpdateKeyspaceResizeRequestRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Name.ValueString(),
+		Keyspace:     keyspace,
+		Body: &operations.UpdateKeyspaceResizeRequestRequestBody{
+			ClusterSize: data.ClusterSize.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return diags
+	}
+	if res.Object == nil {
+		diags.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return diags
+	}
+
+	keyspaces := &VitessKeyspaceResource{client: r.client}
+	diags.Append(keyspaces.waitForKeyspaceResize(ctx, &VitessKeyspaceResourceModel{
+		Organization: data.Organization,
+		Database:     data.Database,
+		Branch:       data.Name,
+		Name:         types.StringValue(keyspace),
+	}, res.Object.ResizeRequestID)...)
+
+	return diags
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+)
+
+// deletionProtectedBranchError explains why a protected branch is not
+// deleted. Like force_delete on backups, the deletion_protected value that
+// counts is the one in state, so turning it off has to be applied first.
+func deletionProtectedBranchError(name types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Branch Is Deletion Protected",
+		fmt.Sprintf("Branch %q has deletion_protected set, so the provider will not delete it. "+
+			"If the branch should be destroyed or replaced, set deletion_protected = false and apply before destroying it.", name.ValueString()),
+	)
+	return diags
+}
+
+// branchDescendants returns the names of the branches created from branch,
+// directly or through other branches, in alphabetical order.
+func branchDescendants(ctx context.Context, client *sdk.PlanetScale, organization, database, branch string) ([]string, error) {
+	children := map[string][]string{}
+
+	res, err := client.DatabaseBranches.ListBranches(ctx, operations.ListBranchesRequest{
+		Organization: organization,
+		Database:     database,
+	})
+	for {
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			break
+		}
+		if res.StatusCode != 200 || res.Object == nil {
+			return nil, fmt.Errorf("unexpected response listing branches of database %q: %s", database, res.RawResponse.Status)
+		}
+
+		for _, b := range res.Object.Data {
+			if b.ParentBranch != nil && *b.ParentBranch != "" {
+				children[*b.ParentBranch] = append(children[*b.ParentBranch], b.Name)
+			}
+		}
+
+		res, err = res.Next()
+	}
+
+	var descendants []string
+	seen := map[string]bool{branch: true}
+	queue := []string{branch}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, child := range children[name] {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child)
+		}
+	}
+
+	sort.Strings(descendants)
+	return descendants, nil
+}
+
+// branchReplacementPlanned reports whether the plan makes Terraform destroy
+// and recreate the branch. The framework does not pass the attributes that
+// require replacement to ModifyPlan, so the plan modifiers of the schema's
+// string attributes, the only ones that require replacement, are run again.
+func branchReplacementPlanned(ctx context.Context, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for name, attr := range req.Plan.Schema.GetAttributes() {
+		stringAttr, ok := attr.(schema.StringAttribute)
+		if !ok || len(stringAttr.PlanModifiers) == 0 {
+			continue
+		}
+
+		modifyReq := planmodifier.StringRequest{
+			Path:           path.Root(name),
+			PathExpression: path.MatchRoot(name),
+			Config:         req.Config,
+			Plan:           req.Plan,
+			State:          req.State,
+			Private:        req.Private,
+		}
+		diags.Append(req.Config.GetAttribute(ctx, modifyReq.Path, &modifyReq.ConfigValue)...)
+		diags.Append(req.Plan.GetAttribute(ctx, modifyReq.Path, &modifyReq.PlanValue)...)
+		diags.Append(req.State.GetAttribute(ctx, modifyReq.Path, &modifyReq.StateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		for _, modifier := range stringAttr.PlanModifiers {
+			modifyResp := planmodifier.StringResponse{PlanValue: modifyReq.PlanValue}
+			modifier.PlanModifyString(ctx, modifyReq, &modifyResp)
+			if modifyResp.RequiresReplace {
+				return true, diags
+			}
+		}
+	}
+
+	return false, diags
+}
+
+// warnDeleteDescendants returns a plan modifier that warns about plans that
+// destroy or replace a branch with delete_descendants set, naming the
+// branches that go with it.
+func warnDeleteDescendants(client *sdk.PlanetScale) planModifier {
+	return func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+		resp.Diagnostics.Append(deleteDescendantsWarning(ctx, client, req)...)
+	}
+}
+
+func deleteDescendantsWarning(ctx context.Context, client *sdk.PlanetScale, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client == nil || req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var deleteDescendants types.Bool
+	var organization, database, name types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("delete_descendants"), &deleteDescendants)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("organization"), &organization)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("database"), &database)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() || !deleteDescendants.ValueBool() {
+		return diags
+	}
+
+	action := "Destroying"
+	if !req.Plan.Raw.IsNull() {
+		replaced, replaceDiags := branchReplacementPlanned(ctx, req)
+		diags.Append(replaceDiags...)
+		if diags.HasError() || !replaced {
+			return diags
+		}
+		action = "Replacing"
+	}
+
+	descendants, err := branchDescendants(ctx, client, organization.ValueString(), database.ValueString(), name.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Unable to list descendant branches", map[string]interface{}{
+			"error": redactedError(err),
+		})
+		diags.AddAttributeWarning(
+			path.Root("delete_descendants"),
+			"Descendant Branches May Be Deleted",
+			fmt.Sprintf("%s branch %q also deletes every branch created from it, because delete_descendants is true. "+
+				"The provider was unable to list them: %s", action, name.ValueString(), redactedError(err)),
+		)
+		return diags
+	}
+	if len(descendants) == 0 {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("delete_descendants"),
+		"Descendant Branches Will Be Deleted",
+		fmt.Sprintf("%s branch %q also deletes the following branches, because delete_descendants is true: %s. "+
+			"Set delete_descendants = false and apply first to keep them.", action, name.ValueString(), strings.Join(descendants, ", ")),
+	)
+	return diags
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchDescendants(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+		if err := expectRequest(r, http.MethodGet, "/organizations/org/databases/db/branches"); err != nil {
+			return err
+		}
+
+		data := "[]"
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			data = `[
+				{"id": "1", "name": "main", "parent_branch": null},
+				{"id": "2", "name": "staging", "parent_branch": "main"},
+				{"id": "3", "name": "feature-b", "parent_branch": "staging"}
+			]`
+		case "2":
+			data = `[
+				{"id": "4", "name": "feature-a", "parent_branch": "staging"},
+				{"id": "5", "name": "hotfix", "parent_branch": "main"},
+				{"id": "6", "name": "unrelated", "parent_branch": "other"}
+			]`
+		}
+
+		writeJSON(w, `{"type": "list", "current_page": 1, "data": `+data+`}`)
+		return nil
+	})
+
+	descendants, err := branchDescendants(context.Background(), client, "org", "db", "main")
+	require.NoError(t, err)
+	require.Equal(t, []string{"feature-a", "feature-b", "hotfix", "staging"}, descendants)
+
+	descendants, err = branchDescendants(context.Background(), client, "org", "db", "staging")
+	require.NoError(t, err)
+	require.Equal(t, []string{"feature-a", "feature-b"}, descendants)
+
+	descendants, err = branchDescendants(context.Background(), client, "org", "db", "feature-a")
+	require.NoError(t, err)
+	require.Empty(t, descendants)
+}
+
+func TestBranchReplacementPlanned(t *testing.T) {
+	t.Parallel()
+
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		config   map[string]tftypes.Value
+		state    map[string]tftypes.Value
+		want     bool
+	}{
+		"vitess unchanged": {
+			resource: NewVitessBranchResource(),
+			config:   map[string]tftypes.Value{"region": str("us-east"), "cluster_size": str("PS_10")},
+			state:    map[string]tftypes.Value{"region": str("us-east"), "cluster_size": str("PS_10")},
+		},
+		"vitess region changed": {
+			resource: NewVitessBranchResource(),
+			config:   map[string]tftypes.Value{"region": str("eu-west")},
+			state:    map[string]tftypes.Value{"region": str("us-east")},
+			want:     true,
+		},
+		"vitess cluster size changed": {
+			resource: NewVitessBranchResource(),
+			config:   map[string]tftypes.Value{"cluster_size": str("PS_20")},
+			state:    map[string]tftypes.Value{"cluster_size": str("PS_10")},
+		},
+		"vitess region not configured": {
+			resource: NewVitessBranchResource(),
+			state:    map[string]tftypes.Value{"region": str("us-east")},
+		},
+		"postgres restore point changed": {
+			resource: NewPostgresBranchResource(),
+			config:   map[string]tftypes.Value{"restore_point": str("2024-01-02T00:00:00Z")},
+			state:    map[string]tftypes.Value{"restore_point": str("2024-01-01T00:00:00Z")},
+			want:     true,
+		},
+		"postgres major version changed": {
+			resource: NewPostgresBranchResource(),
+			config:   map[string]tftypes.Value{"major_version": str("17")},
+			state:    map[string]tftypes.Value{"major_version": str("16")},
+			want:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var schemaResp resource.SchemaResponse
+			tc.resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			s := schemaResp.Schema
+
+			// Attributes that are not configured keep their state value in
+			// the plan, as UseStateForUnknown would leave them.
+			planned := map[string]tftypes.Value{}
+			for attr, value := range tc.state {
+				planned[attr] = value
+			}
+			for attr, value := range tc.config {
+				planned[attr] = value
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: resourceObject(t, s, tc.config)},
+				Plan:   tfsdk.Plan{Schema: s, Raw: resourceObject(t, s, planned)},
+				State:  tfsdk.State{Schema: s, Raw: resourceObject(t, s, tc.state)},
+			}
+
+			replaced, diags := branchReplacementPlanned(ctx, req)
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tc.want, replaced)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+)
+
+// branchProductionChange is the promotion or demotion needed to bring a
+// branch to its planned production value.
+type branchProductionChange int
+
+const (
+	noBranchProductionChange branchProductionChange = iota
+	branchPromotion
+	branchDemotion
+)
+
+// plannedProductionChange compares the planned production value with the
+// current one. A null or unknown plan means production is not set in the
+// configuration, so the branch is left as it is.
+func plannedProductionChange(planned types.Bool, current bool) branchProductionChange {
+	if planned.IsNull() || planned.IsUnknown() || planned.ValueBool() == current {
+		return noBranchProductionChange
+	}
+	if planned.ValueBool() {
+		return branchPromotion
+	}
+	return branchDemotion
+}
+
+// startBranchProductionChange asks the API to promote or demote the branch.
+// The API returns before the branch has switched, so callers poll the branch
+// afterwards.
+func startBranchProductionChange(ctx context.Context, client *sdk.PlanetScale, organization, database, branch string, change branchProductionChange) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var rawResponse *http.Response
+	var err error
+	switch change {
+	case branchPromotion:
+		var res *operations.PromoteBranchResponse
+		res, err = client.DatabaseBranches.PromoteBranch(ctx, operations.PromoteBranchRequest{
+			Organization: organization,
+			Database:     database,
+			Branch:       branch,
+		})
+		if res != nil {
+			rawResponse = res.RawResponse
+		}
+	case branchDemotion:
+		var res *operations.DemoteBranchResponse
+		res, err = client.DatabaseBranches.DemoteBranch(ctx, operations.DemoteBranchRequest{
+			Organization: organization,
+			Database:     database,
+			Branch:       branch,
+		})
+		if res != nil {
+			rawResponse = res.RawResponse
+		}
+	default:
+		return diags
+	}
+
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if rawResponse == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", rawResponse))
+		return diags
+	}
+	if rawResponse.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, rawResponse)...)
+	}
+
+	return diags
+}
+
+// changeProduction promotes or demotes the branch and waits until the API
+// reports the new production state, refreshing data from the branch.
+func (r *VitessBranchResource) changeProduction(ctx context.Context, data *VitessBranchResourceModel, change branchProductionChange) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if change == noBranchProductionChange {
+		return diags
+	}
+
+	request, requestDiags := data.ToOperationsGetVitessBranchRequest(ctx)
+	diags.Append(requestDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Changing production state of Vitess branch", map[string]interface{}{
+		"branch":     request.Branch,
+		"production": change == branchPromotion,
+	})
+
+	diags.Append(startBranchProductionChange(ctx, r.client, request.Organization, request.Database, request.Branch, change)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	waitFor := r.client.DatabaseBranches.GetVitessBranchWaitForPromoted()
+	progress := newWaitProgress("Vitess branch to be promoted", typicalBranchPromotionDuration)
+	if change == branchDemotion {
+		waitFor = r.client.DatabaseBranches.GetVitessBranchWaitForDemoted()
+		progress = newWaitProgress("Vitess branch to be demoted", typicalBranchPromotionDuration)
+	}
+	res, err := r.client.DatabaseBranches.GetVitessBranch(ctx, *request, operations.WithPolling(
+		waitFor,
+		withPollingBackoff,
+		polling.WithAttemptHook(progress.Observe),
+	))
+	diags.Append(progress.Diagnostics()...)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return diags
+	}
+	if res.Object == nil {
+		diags.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return diags
+	}
+	diags.Append(data.RefreshFromOperationsGetVitessBranchResponseBody(ctx, res.Object)...)
+
+	return diags
+}
+
+// changeProduction is the Postgres counterpart of
+// VitessBranchResource.changeProduction.
+func (r *PostgresBranchResource) changeProduction(ctx context.Context, data *PostgresBranchResourceModel, change branchProductionChange) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if change == noBranchProductionChange {
+		return diags
+	}
+
+	request, requestDiags := data.ToOperationsGetPostgresBranchRequest(ctx)
+	diags.Append(requestDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Changing production state of Postgres branch", map[string]interface{}{
+		"branch":     request.Branch,
+		"production": change == branchPromotion,
+	})
+
+	diags.Append(startBranchProductionChange(ctx, r.client, request.Organization, request.Database, request.Branch, change)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	waitFor := r.client.DatabaseBranches.GetPostgresBranchWaitForPromoted()
+	progress := newWaitProgress("Postgres branch to be promoted", typicalBranchPromotionDuration)
+	if change == branchDemotion {
+		waitFor = r.client.DatabaseBranches.GetPostgresBranchWaitForDemoted()
+		progress = newWaitProgress("Postgres branch to be demoted", typicalBranchPromotionDuration)
+	}
+	res, err := r.client.DatabaseBranches.GetPostgresBranch(ctx, *request, operations.WithPolling(
+		waitFor,
+		withPollingBackoff,
+		polling.WithAttemptHook(progress.Observe),
+	))
+	diags.Append(progress.Diagnostics()...)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return diags
+	}
+	if res.Object == nil {
+		diags.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return diags
+	}
+	diags.Append(data.RefreshFromOperationsGetPostgresBranchResponseBody(ctx, res.Object)...)
+
+	return diags
+}
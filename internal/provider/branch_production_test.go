@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlannedProductionChange(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, noBranchProductionChange, plannedProductionChange(types.BoolNull(), true))
+	require.Equal(t, noBranchProductionChange, plannedProductionChange(types.BoolUnknown(), false))
+	require.Equal(t, noBranchProductionChange, plannedProductionChange(types.BoolValue(true), true))
+	require.Equal(t, noBranchProductionChange, plannedProductionChange(types.BoolValue(false), false))
+	require.Equal(t, branchPromotion, plannedProductionChange(types.BoolValue(true), false))
+	require.Equal(t, branchDemotion, plannedProductionChange(types.BoolValue(false), true))
+}
+
+func TestStartBranchProductionChange(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+		if r.Method != http.MethodPost {
+			return errors.New("expected POST")
+		}
+		mu.Lock()
+		calls = append(calls, r.URL.Path)
+		mu.Unlock()
+
+		if r.URL.Path == "/organizations/org/databases/db/branches/locked/promote" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"code": "forbidden", "message": "Not allowed"}`))
+			return nil
+		}
+
+		writeJSON(w, `{"id": "1", "name": "main", "state": "ready", "ready": true, "production": false}`)
+		return nil
+	})
+
+	diags := startBranchProductionChange(context.Background(), client, "org", "db", "main", branchPromotion)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	diags = startBranchProductionChange(context.Background(), client, "org", "db", "main", branchDemotion)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	diags = startBranchProductionChange(context.Background(), client, "org", "db", "main", noBranchProductionChange)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	diags = startBranchProductionChange(context.Background(), client, "org", "db", "locked", branchPromotion)
+	require.True(t, diags.HasError())
+
+	require.Equal(t, []string{
+		"/organizations/org/databases/db/branches/main/promote",
+		"/organizations/org/databases/db/branches/main/demote",
+		"/organizations/org/databases/db/branches/locked/promote",
+	}, calls)
+}
@@ -0,0 +1,633 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+	"github.com/planetscale/terraform-provider-planetscale/internal/validators"
+)
+
+const (
+	branchRestoreKindMySQL      = "mysql"
+	branchRestoreKindPostgreSQL = "postgresql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BranchRestoreResource{}
+var _ resource.ResourceWithConfigure = &BranchRestoreResource{}
+
+func NewBranchRestoreResource() resource.Resource {
+	return &BranchRestoreResource{}
+}
+
+// BranchRestoreResource restores a backup or point in time into a new branch.
+// It only ever creates and deletes the restored branch; the branch the backup
+// was taken from is left to the resource that manages it.
+type BranchRestoreResource struct {
+	// Provider configured SDK client.
+	client *sdk.PlanetScale
+}
+
+// BranchRestoreResourceModel describes the resource data model.
+type BranchRestoreResourceModel struct {
+	BackupBranch     types.String `tfsdk:"backup_branch"`
+	BackupID         types.String `tfsdk:"backup_id"`
+	ClusterSize      types.String `tfsdk:"cluster_size"`
+	Database         types.String `tfsdk:"database"`
+	HTMLURL          types.String `tfsdk:"html_url"`
+	ID               types.String `tfsdk:"id"`
+	Kind             types.String `tfsdk:"kind"`
+	MysqlAddress     types.String `tfsdk:"mysql_address"`
+	MysqlEdgeAddress types.String `tfsdk:"mysql_edge_address"`
+	Name             types.String `tfsdk:"name"`
+	Organization     types.String `tfsdk:"organization"`
+	ParentBranch     types.String `tfsdk:"parent_branch"`
+	Ready            types.Bool   `tfsdk:"ready"`
+	Region           types.String `tfsdk:"region"`
+	RestorePoint     types.String `tfsdk:"restore_point"`
+	State            types.String `tfsdk:"state"`
+}
+
+func (r *BranchRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_restore"
+}
+
+func (r *BranchRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a branch backup, or a point in time of a Postgres branch, into a new branch and waits for it to be ready. " +
+			"Intended for disaster-recovery drills: the restored branch is created and destroyed by this resource, while the branch " +
+			"the backup was taken from is never modified. Every argument requires replacement, so changing the backup or restore " +
+			"point restores a fresh branch. Postgres branches have no branch level address, so connect to a `postgresql` restore " +
+			"through a `planetscale_postgres_branch_role` created on it.",
+		Attributes: map[string]schema.Attribute{
+			"backup_branch": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: `The name of the branch the backup was taken from. Required with ` + "`" + `backup_id` + "`" + ` so the backup can be checked before restoring. Requires replacement if changed.`,
+			},
+			"backup_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("restore_point")),
+					stringvalidator.AlsoRequires(path.MatchRoot("backup_branch")),
+				},
+				Description: `The ID of the backup to restore. The backup must be in the ` + "`" + `success` + "`" + ` state. Exactly one of ` + "`" + `backup_id` + "`" + ` and ` + "`" + `restore_point` + "`" + ` must be set. Requires replacement if changed.`,
+			},
+			"cluster_size": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: `The cluster size of the restored branch. Requires replacement if changed.`,
+			},
+			"database": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `The name of the database to restore into. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
+			},
+			"html_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: `Planetscale app URL for the restored branch`,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: `The ID of the restored branch`,
+			},
+			"kind": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(branchRestoreKindMySQL, branchRestoreKindPostgreSQL),
+				},
+				Description: `The kind of database to restore into. must be one of ["mysql", "postgresql"]; Requires replacement if changed.`,
+			},
+			"mysql_address": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: `The MySQL address of the restored branch. Only set for ` + "`" + `mysql` + "`" + ` restores.`,
+			},
+			"mysql_edge_address": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: `The MySQL edge address of the restored branch. Only set for ` + "`" + `mysql` + "`" + ` restores.`,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: `The name of the branch to restore into. Requires replacement if changed.`,
+			},
+			"organization": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `The name of the organization the database belongs to. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
+			},
+			"parent_branch": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: `The name of the parent branch of the restored branch. Defaults to the database's default branch. Requires replacement if changed.`,
+			},
+			"ready": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: `Whether or not the restored branch is ready to serve queries`,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: `The region to restore into. Defaults to the database's default region. Requires replacement if changed.`,
+			},
+			"restore_point": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.IsRFC3339(),
+				},
+				Description: `The point in time to restore, as an RFC3339 timestamp (e.g. 2023-01-01T00:00:00Z). Only available for ` + "`" + `postgresql` + "`" + ` restores. Requires replacement if changed.`,
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: `The current state of the restored branch`,
+			},
+		},
+	}
+}
+
+func (r *BranchRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.PlanetScale)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.PlanetScale, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// checkRestoreSource rejects point-in-time restores of MySQL branches and
+// checks, when everything needed to look them up is known, that the restore
+// point is within the restorable window or that the backup to restore
+// completed successfully.
+func (r *BranchRestoreResource) checkRestoreSource(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var data *BranchRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Kind.ValueString() == branchRestoreKindMySQL && !data.RestorePoint.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("restore_point"),
+			"Point-In-Time Restore Not Supported",
+			"Restoring to a point in time is only available for postgresql branches. Restore a backup with backup_id instead.",
+		)
+		return
+	}
+
+	if r.client != nil && data.Kind.ValueString() == branchRestoreKindPostgreSQL && !data.RestorePoint.IsNull() && !data.RestorePoint.IsUnknown() &&
+		!data.ParentBranch.IsUnknown() && !data.Organization.IsUnknown() && !data.Database.IsUnknown() {
+		resp.Diagnostics.Append(checkPostgresRestorePoint(ctx, r.client, data.Organization.ValueString(), data.Database.ValueString(), data.ParentBranch.ValueString(), data.RestorePoint)...)
+		return
+	}
+
+	if r.client == nil || data.Kind.IsUnknown() || data.BackupID.IsNull() || data.BackupID.IsUnknown() ||
+		data.BackupBranch.IsUnknown() || data.Organization.IsUnknown() || data.Database.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkBackup(ctx, data)...)
+}
+
+// checkBackup returns an error on backup_id unless the backup exists and is
+// in the success state, since restoring an incomplete backup fails only after
+// the branch has been created.
+func (r *BranchRestoreResource) checkBackup(ctx context.Context, data *BranchRestoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	backupID := data.BackupID.ValueString()
+	state, err := r.backupState(ctx, data)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("backup_id"),
+			"Unable to Check Backup",
+			fmt.Sprintf("Retrieving backup %s of branch %q failed: %s", backupID, data.BackupBranch.ValueString(), redactedError(err)),
+		)
+		return diags
+	}
+	if state != string(operations.GetVitessBranchBackupStateSuccess) {
+		diags.AddAttributeError(
+			path.Root("backup_id"),
+			"Backup Not Restorable",
+			fmt.Sprintf("Backup %s of branch %q is %q. Only backups in the %q state can be restored.",
+				backupID, data.BackupBranch.ValueString(), state, operations.GetVitessBranchBackupStateSuccess),
+		)
+	}
+
+	return diags
+}
+
+func (r *BranchRestoreResource) backupState(ctx context.Context, data *BranchRestoreResourceModel) (string, error) {
+	if data.Kind.ValueString() == branchRestoreKindPostgreSQL {
+		res, err := r.client.Backups.GetPostgresBranchBackup(ctx, operations.GetPostgresBranchBackupRequest{
+			ID:           data.BackupID.ValueString(),
+			Organization: data.Organization.ValueString(),
+			Database:     data.Database.ValueString(),
+			Branch:       data.BackupBranch.ValueString(),
+		})
+		if err != nil {
+			return "", err
+		}
+		if res == nil || res.StatusCode != 200 || res.Object == nil {
+			return "", errors.New("unexpected response from API")
+		}
+		return string(res.Object.State), nil
+	}
+
+	res, err := r.client.Backups.GetVitessBranchBackup(ctx, operations.GetVitessBranchBackupRequest{
+		ID:           data.BackupID.ValueString(),
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.BackupBranch.ValueString(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if res == nil || res.StatusCode != 200 || res.Object == nil {
+		return "", errors.New("unexpected response from API")
+	}
+	return string(res.Object.State), nil
+}
+
+func (r *BranchRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *BranchRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The backup may have been deleted or expired since the plan was made.
+	if !data.BackupID.IsNull() {
+		resp.Diagnostics.Append(r.checkBackup(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.Kind.ValueString() == branchRestoreKindPostgreSQL {
+		resp.Diagnostics.Append(r.restorePostgresBranch(ctx, data)...)
+	} else {
+		resp.Diagnostics.Append(r.restoreVitessBranch(ctx, data)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchRestoreResource) restoreVitessBranch(ctx context.Context, data *BranchRestoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := r.client.DatabaseBranches.CreateVitessBranch(ctx, operations.CreateVitessBranchRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Body: &operations.CreateVitessBranchRequestBody{
+			Name:         data.Name.ValueString(),
+			ParentBranch: data.ParentBranch.ValueStringPointer(),
+			BackupID:     data.BackupID.ValueStringPointer(),
+			Region:       data.Region.ValueStringPointer(),
+			ClusterSize:  data.ClusterSize.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 201 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return diags
+	}
+
+	progress := newWaitProgress("restored branch to be ready", typicalBranchReadyDuration)
+	getRes, err := r.client.DatabaseBranches.GetVitessBranch(ctx, data.toOperationsGetVitessBranchRequest(), operations.WithPolling(
+		r.client.DatabaseBranches.GetVitessBranchWaitForReady(),
+		withPollingBackoff,
+		polling.WithAttemptHook(progress.Observe),
+	))
+	diags.Append(progress.Diagnostics()...)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if getRes == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", getRes))
+		return diags
+	}
+	if getRes.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, getRes.RawResponse)...)
+		return diags
+	}
+	if getRes.Object == nil {
+		diags.AddError("unexpected response from API. Got an unexpected response body", debugResponse(getRes.RawResponse))
+		return diags
+	}
+	data.refreshFromVitessBranch(getRes.Object)
+
+	return diags
+}
+
+func (r *BranchRestoreResource) restorePostgresBranch(ctx context.Context, data *BranchRestoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	res, err := r.client.DatabaseBranches.CreatePostgresBranch(ctx, operations.CreatePostgresBranchRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Body: &operations.CreatePostgresBranchRequestBody{
+			Name:         data.Name.ValueString(),
+			ParentBranch: data.ParentBranch.ValueStringPointer(),
+			BackupID:     data.BackupID.ValueStringPointer(),
+			Region:       data.Region.ValueStringPointer(),
+			RestorePoint: data.RestorePoint.ValueStringPointer(),
+			ClusterSize:  data.ClusterSize.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 201 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return diags
+	}
+
+	progress := newWaitProgress("restored branch to be ready", typicalBranchReadyDuration)
+	getRes, err := r.client.DatabaseBranches.GetPostgresBranch(ctx, data.toOperationsGetPostgresBranchRequest(), operations.WithPolling(
+		r.client.DatabaseBranches.GetPostgresBranchWaitForReady(),
+		withPollingBackoff,
+		polling.WithAttemptHook(progress.Observe),
+	))
+	diags.Append(progress.Diagnostics()...)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if getRes == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", getRes))
+		return diags
+	}
+	if getRes.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, getRes.RawResponse)...)
+		return diags
+	}
+	if getRes.Object == nil {
+		diags.AddError("unexpected response from API. Got an unexpected response body", debugResponse(getRes.RawResponse))
+		return diags
+	}
+	data.refreshFromPostgresBranch(getRes.Object)
+
+	return diags
+}
+
+func (r *BranchRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *BranchRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Kind.ValueString() == branchRestoreKindPostgreSQL {
+		res, err := r.client.DatabaseBranches.GetPostgresBranch(ctx, data.toOperationsGetPostgresBranchRequest())
+		if err != nil {
+			if isNotFoundError(err) {
+				removeNotFoundResource(ctx, resp)
+				return
+			}
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
+			return
+		}
+		if res == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+			return
+		}
+		if isNotFoundResponse(res.RawResponse) {
+			removeNotFoundResource(ctx, resp)
+			return
+		}
+		if res.StatusCode != 200 {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
+			return
+		}
+		if res.Object == nil {
+			resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+			return
+		}
+		data.refreshFromPostgresBranch(res.Object)
+	} else {
+		res, err := r.client.DatabaseBranches.GetVitessBranch(ctx, data.toOperationsGetVitessBranchRequest())
+		if err != nil {
+			if isNotFoundError(err) {
+				removeNotFoundResource(ctx, resp)
+				return
+			}
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
+			return
+		}
+		if res == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+			return
+		}
+		if isNotFoundResponse(res.RawResponse) {
+			removeNotFoundResource(ctx, resp)
+			return
+		}
+		if res.StatusCode != 200 {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
+			return
+		}
+		if res.Object == nil {
+			resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+			return
+		}
+		data.refreshFromVitessBranch(res.Object)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores the plan: every argument requires replacement, so the
+// only changes that reach it are computed values being refreshed.
+func (r *BranchRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *BranchRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the restored branch only. Descendants are not deleted, so
+// branches created from the restore outside Terraform block the delete. A
+// branch that is already gone is treated as deleted.
+func (r *BranchRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BranchRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Kind.ValueString() == branchRestoreKindPostgreSQL {
+		res, err := r.client.DatabaseBranches.DeletePostgresBranch(ctx, operations.DeletePostgresBranchRequest{
+			Organization: data.Organization.ValueString(),
+			Database:     data.Database.ValueString(),
+			Branch:       data.Name.ValueString(),
+		})
+		if err != nil {
+			if isNotFoundError(err) {
+				return
+			}
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
+			return
+		}
+		if res == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+			return
+		}
+		if res.StatusCode != 204 && !isNotFoundResponse(res.RawResponse) {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
+		}
+		return
+	}
+
+	res, err := r.client.DatabaseBranches.DeleteVitessBranch(ctx, operations.DeleteVitessBranchRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Name.ValueString(),
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	if res.StatusCode != 204 && !isNotFoundResponse(res.RawResponse) {
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
+	}
+}
+
+func (m *BranchRestoreResourceModel) toOperationsGetVitessBranchRequest() operations.GetVitessBranchRequest {
+	return operations.GetVitessBranchRequest{
+		Organization: m.Organization.ValueString(),
+		Database:     m.Database.ValueString(),
+		Branch:       m.Name.ValueString(),
+	}
+}
+
+func (m *BranchRestoreResourceModel) toOperationsGetPostgresBranchRequest() operations.GetPostgresBranchRequest {
+	return operations.GetPostgresBranchRequest{
+		Organization: m.Organization.ValueString(),
+		Database:     m.Database.ValueString(),
+		Branch:       m.Name.ValueString(),
+	}
+}
+
+func (m *BranchRestoreResourceModel) refreshFromVitessBranch(branch *operations.GetVitessBranchResponseBody) {
+	m.ID = types.StringValue(branch.ID)
+	m.ClusterSize = types.StringValue(branch.ClusterSize)
+	m.HTMLURL = types.StringValue(branch.HTMLURL)
+	m.MysqlAddress = types.StringValue(branch.MysqlAddress)
+	m.MysqlEdgeAddress = types.StringValue(branch.MysqlEdgeAddress)
+	m.Ready = types.BoolValue(branch.Ready)
+	m.State = types.StringValue(string(branch.State))
+}
+
+func (m *BranchRestoreResourceModel) refreshFromPostgresBranch(branch *operations.GetPostgresBranchResponseBody) {
+	m.ID = types.StringValue(branch.ID)
+	m.ClusterSize = types.StringValue(branch.ClusterSize)
+	m.HTMLURL = types.StringValue(branch.HTMLURL)
+	m.MysqlAddress = types.StringNull()
+	m.MysqlEdgeAddress = types.StringNull()
+	m.Ready = types.BoolValue(branch.Ready)
+	m.State = types.StringValue(string(branch.State))
+}
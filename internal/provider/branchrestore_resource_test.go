@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchRestoreCheckBackup(t *testing.T) {
+	t.Parallel()
+
+	newResource := func(state string) *BranchRestoreResource {
+		return &BranchRestoreResource{
+			client: newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+				if err := expectRequest(r, http.MethodGet, "/organizations/org/databases/db/branches/main/backups/backup-1"); err != nil {
+					return err
+				}
+				writeJSON(w, `{"id": "backup-1", "name": "nightly", "state": "`+state+`"}`)
+				return nil
+			}),
+		}
+	}
+
+	for _, kind := range []string{branchRestoreKindMySQL, branchRestoreKindPostgreSQL} {
+		data := &BranchRestoreResourceModel{
+			Organization: types.StringValue("org"),
+			Database:     types.StringValue("db"),
+			Kind:         types.StringValue(kind),
+			BackupID:     types.StringValue("backup-1"),
+			BackupBranch: types.StringValue("main"),
+		}
+
+		diags := newResource("success").checkBackup(context.Background(), data)
+		require.False(t, diags.HasError(), diags)
+
+		diags = newResource("running").checkBackup(context.Background(), data)
+		require.True(t, diags.HasError())
+		require.Equal(t, "Backup Not Restorable", diags.Errors()[0].Summary())
+		require.Contains(t, diags.Errors()[0].Detail(), `"running"`)
+	}
+}
+
+func TestBranchRestoreDeleteAlreadyDeleted(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range []string{branchRestoreKindMySQL, branchRestoreKindPostgreSQL} {
+		t.Run(kind, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			r := &BranchRestoreResource{
+				client: newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+					calls.Add(1)
+					if err := expectRequest(r, http.MethodDelete, "/organizations/org/databases/db/branches/restore-drill"); err != nil {
+						return err
+					}
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"code": "not_found", "message": "Not Found"}`))
+					return nil
+				}),
+			}
+
+			ctx := context.Background()
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			s := schemaResp.Schema
+
+			state := tfsdk.State{Schema: s, Raw: resourceObject(t, s, map[string]tftypes.Value{
+				"organization": tftypes.NewValue(tftypes.String, "org"),
+				"database":     tftypes.NewValue(tftypes.String, "db"),
+				"name":         tftypes.NewValue(tftypes.String, "restore-drill"),
+				"kind":         tftypes.NewValue(tftypes.String, kind),
+			})}
+
+			var resp resource.DeleteResponse
+			r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.EqualValues(t, 1, calls.Load())
+		})
+	}
+}
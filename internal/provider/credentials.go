@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// Files written by the pscale CLI in its configuration directory.
+const (
+	pscaleConfigFile      = "pscale.yml"
+	pscaleAccessTokenFile = "access-token"
+)
+
+// pscaleConfig holds the keys of the pscale configuration file the provider
+// understands. The org key is deliberately not read: it follows pscale org
+// switch, and a default organization that changes with it would replace
+// every resource relying on the default.
+type pscaleConfig struct {
+	ServiceToken   string `yaml:"service-token"`
+	ServiceTokenID string `yaml:"service-token-id"`
+}
+
+// credentialSource is one place credentials can be read from. Sources are
+// tried in order and the first one holding any credential is used.
+type credentialSource struct {
+	name           string
+	serviceToken   string
+	serviceTokenID string
+	accessToken    string
+}
+
+// pscaleConfigDir returns the pscale configuration directory: the provider
+// pscale_config_dir attribute, PLANETSCALE_CONFIG_DIR, or the pscale default
+// of ~/.config/planetscale.
+func pscaleConfigDir(data *PlanetscaleProviderModel) string {
+	if dir := data.PscaleConfigDir.ValueString(); dir != "" {
+		return dir
+	}
+
+	if dir := os.Getenv("PLANETSCALE_CONFIG_DIR"); dir != "" {
+		return dir
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".config", "planetscale")
+}
+
+// readPscaleConfig reads the pscale configuration file and access token
+// from dir. Missing files are not an error.
+func readPscaleConfig(dir string) (pscaleConfig, string, error) {
+	var config pscaleConfig
+
+	if dir == "" {
+		return config, "", nil
+	}
+
+	contents, err := os.ReadFile(filepath.Join(dir, pscaleConfigFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return config, "", err
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(contents, &config); err != nil {
+			return config, "", err
+		}
+	}
+
+	accessToken, err := os.ReadFile(filepath.Join(dir, pscaleAccessTokenFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return config, "", err
+	}
+
+	return config, strings.TrimSpace(string(accessToken)), nil
+}
+
+// resolveProviderCredentials picks the credentials the provider authenticates
+// with. Sources are tried in this order, and the first one setting any
+// credential wins:
+//
+//  1. The service_token and service_token_id, or access_token, attributes.
+//  2. PLANETSCALE_SERVICE_TOKEN and PLANETSCALE_SERVICE_TOKEN_ID, then
+//     PLANETSCALE_ACCESS_TOKEN.
+//  3. The service-token and service-token-id keys of the pscale configuration
+//     file, then the OAuth access token stored by pscale auth login.
+//
+// Within a source a service token takes precedence over an access token. A
+// service token is only usable together with its ID.
+func resolveProviderCredentials(ctx context.Context, data *PlanetscaleProviderModel, config pscaleConfig, pscaleAccessToken string) (shared.Security, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sources := []credentialSource{
+		{
+			name:           "provider configuration",
+			serviceToken:   data.ServiceToken.ValueString(),
+			serviceTokenID: data.ServiceTokenID.ValueString(),
+			accessToken:    data.AccessToken.ValueString(),
+		},
+		{
+			name:           "environment variables",
+			serviceToken:   os.Getenv("PLANETSCALE_SERVICE_TOKEN"),
+			serviceTokenID: os.Getenv("PLANETSCALE_SERVICE_TOKEN_ID"),
+			accessToken:    os.Getenv("PLANETSCALE_ACCESS_TOKEN"),
+		},
+		{
+			name:           "pscale configuration",
+			serviceToken:   config.ServiceToken,
+			serviceTokenID: config.ServiceTokenID,
+			accessToken:    pscaleAccessToken,
+		},
+	}
+
+	for _, source := range sources {
+		switch {
+		case source.serviceToken != "" && source.serviceTokenID != "":
+			tflog.Info(ctx, "Authenticating with a service token", map[string]interface{}{
+				"source": source.name,
+			})
+			return shared.Security{
+				ServiceToken:   source.serviceToken,
+				ServiceTokenID: source.serviceTokenID,
+			}, diags
+		case source.serviceToken != "" || source.serviceTokenID != "":
+			diags.AddError(
+				"Incomplete Provider Security Configuration",
+				"A service token was found in the "+source.name+" without its ID, or an ID without its token. "+
+					"Set both service_token and service_token_id (PLANETSCALE_SERVICE_TOKEN and PLANETSCALE_SERVICE_TOKEN_ID).",
+			)
+			return shared.Security{}, diags
+		case source.accessToken != "":
+			tflog.Info(ctx, "Authenticating with an OAuth access token", map[string]interface{}{
+				"source": source.name,
+			})
+			return shared.Security{
+				AccessToken: source.accessToken,
+			}, diags
+		}
+	}
+
+	diags.AddError(
+		"Missing Provider Security Configuration",
+		"Configure a service token with the service_token and service_token_id attributes "+
+			"(or the PLANETSCALE_SERVICE_TOKEN and PLANETSCALE_SERVICE_TOKEN_ID environment variables), "+
+			"an OAuth access token with the access_token attribute (or PLANETSCALE_ACCESS_TOKEN), "+
+			"or log in with pscale auth login.",
+	)
+
+	return shared.Security{}, diags
+}
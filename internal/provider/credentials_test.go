@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func unsetCredentialEnv(t *testing.T) {
+	t.Helper()
+
+	for _, name := range []string{"PLANETSCALE_SERVICE_TOKEN", "PLANETSCALE_SERVICE_TOKEN_ID", "PLANETSCALE_ACCESS_TOKEN"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestResolveProviderCredentialsPrecedence(t *testing.T) {
+	unsetCredentialEnv(t)
+	t.Setenv("PLANETSCALE_ACCESS_TOKEN", "env-access-token")
+
+	config := pscaleConfig{ServiceToken: "file-token", ServiceTokenID: "file-id"}
+
+	// Provider configuration wins over environment variables.
+	security, diags := resolveProviderCredentials(context.Background(), &PlanetscaleProviderModel{
+		ServiceToken:   types.StringValue("token"),
+		ServiceTokenID: types.StringValue("id"),
+	}, config, "file-access-token")
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Equal(t, "id", security.ServiceTokenID)
+	require.Empty(t, security.AccessToken)
+
+	// Environment variables win over the pscale configuration.
+	security, diags = resolveProviderCredentials(context.Background(), &PlanetscaleProviderModel{}, config, "file-access-token")
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Equal(t, "env-access-token", security.AccessToken)
+	require.Empty(t, security.ServiceToken)
+
+	// Within the pscale configuration a service token wins over the access token.
+	t.Setenv("PLANETSCALE_ACCESS_TOKEN", "")
+	security, diags = resolveProviderCredentials(context.Background(), &PlanetscaleProviderModel{}, config, "file-access-token")
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Equal(t, "file-id", security.ServiceTokenID)
+}
+
+func TestResolveProviderCredentialsErrors(t *testing.T) {
+	unsetCredentialEnv(t)
+
+	_, diags := resolveProviderCredentials(context.Background(), &PlanetscaleProviderModel{
+		ServiceToken: types.StringValue("token"),
+	}, pscaleConfig{}, "file-access-token")
+	require.True(t, diags.HasError())
+
+	_, diags = resolveProviderCredentials(context.Background(), &PlanetscaleProviderModel{}, pscaleConfig{}, "")
+	require.True(t, diags.HasError())
+}
+
+func TestReadPscaleConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, pscaleConfigFile), []byte("org: acme\nservice-token-id: id\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, pscaleAccessTokenFile), []byte("oauth-token\n"), 0o600))
+
+	config, accessToken, err := readPscaleConfig(dir)
+	require.NoError(t, err)
+	require.Equal(t, "id", config.ServiceTokenID)
+	require.Equal(t, "oauth-token", accessToken)
+
+	config, accessToken, err = readPscaleConfig(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	require.Empty(t, config.ServiceTokenID)
+	require.Empty(t, accessToken)
+}
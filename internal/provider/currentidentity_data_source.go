@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CurrentIdentityDataSource{}
+var _ datasource.DataSourceWithConfigure = &CurrentIdentityDataSource{}
+
+const (
+	currentIdentityKindServiceToken = "service_token"
+	currentIdentityKindUser         = "user"
+)
+
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &CurrentIdentityDataSource{}
+}
+
+// CurrentIdentityDataSource is the data source implementation.
+type CurrentIdentityDataSource struct {
+	// Provider configured SDK client.
+	client *sdk.PlanetScale
+}
+
+// CurrentIdentityDataSourceModel describes the data model.
+type CurrentIdentityDataSourceModel struct {
+	AccessNames  []types.String                  `tfsdk:"access_names"`
+	Accesses     []tfTypes.CurrentIdentityAccess `tfsdk:"accesses"`
+	DisplayName  types.String                    `tfsdk:"display_name"`
+	ExpiresAt    types.String                    `tfsdk:"expires_at"`
+	ID           types.String                    `tfsdk:"id"`
+	Kind         types.String                    `tfsdk:"kind"`
+	LastUsedAt   types.String                    `tfsdk:"last_used_at"`
+	Name         types.String                    `tfsdk:"name"`
+	Organization types.String                    `tfsdk:"organization"`
+	User         *tfTypes.CurrentIdentityUser    `tfsdk:"user"`
+}
+
+// Metadata returns the data source type name.
+func (r *CurrentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+// Schema defines the schema for the data source.
+func (r *CurrentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the identity the provider authenticates as. For service tokens this includes the access grants of the token, which can be checked in preconditions before resources that need them are changed.",
+
+		Attributes: map[string]schema.Attribute{
+			"access_names": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: `The distinct names of the accesses granted to the service token, such as ` + "`" + `restore_production_branch_backup` + "`",
+			},
+			"accesses": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"access": schema.StringAttribute{
+							Computed:    true,
+							Description: `The name of the access`,
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: `The description of the access`,
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: `The ID of the resource the access applies to`,
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:    true,
+							Description: `The name of the resource the access applies to`,
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: `The type of the resource the access applies to`,
+						},
+					},
+				},
+				Description: `The accesses granted to the service token`,
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: `The display name of the service token or user`,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: `When the service token expires`,
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: `The ID of the service token or user`,
+			},
+			"kind": schema.StringAttribute{
+				Computed:    true,
+				Description: `The kind of identity, either ` + "`" + `service_token` + "`" + ` or ` + "`" + `user` + "`",
+			},
+			"last_used_at": schema.StringAttribute{
+				Computed:    true,
+				Description: `When the service token was last used`,
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: `The name of the service token or user`,
+			},
+			"organization": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: `The name of the organization the service token belongs to. Used when the provider authenticates with a service token. Defaults to the provider ` + "`" + `organization` + "`" + `.`,
+			},
+			"user": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"display_name": schema.StringAttribute{
+						Computed:    true,
+						Description: `The display name of the user`,
+					},
+					"email": schema.StringAttribute{
+						Computed:    true,
+						Description: `The email of the user`,
+					},
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: `The ID of the user`,
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: `The name of the user`,
+					},
+				},
+				Description: `The user the provider authenticates as. Null when authenticating with a service token.`,
+			},
+		},
+	}
+}
+
+func (r *CurrentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.PlanetScale)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *sdk.PlanetScale, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CurrentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentIdentityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serviceTokenID := r.client.ServiceTokenID(ctx); serviceTokenID != "" {
+		r.readServiceToken(ctx, serviceTokenID, &data, resp)
+	} else {
+		r.readUser(ctx, &data, resp)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrentIdentityDataSource) readServiceToken(ctx context.Context, serviceTokenID string, data *CurrentIdentityDataSourceModel, resp *datasource.ReadResponse) {
+	if data.Organization.IsNull() || data.Organization.ValueString() == "" {
+		data.Organization = types.StringValue(r.client.Defaults().Organization)
+	}
+	if data.Organization.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organization"),
+			"Missing Organization",
+			"The provider authenticates with a service token, which belongs to an organization. Set organization here or in the provider to look up the service token and its accesses.",
+		)
+		return
+	}
+
+	request := operations.GetServiceTokenRequest{
+		Organization: data.Organization.ValueString(),
+		ID:           serviceTokenID,
+	}
+	res, err := r.client.ServiceTokens.GetServiceToken(ctx, request)
+	if err != nil {
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	switch res.StatusCode {
+	case 200:
+	case 403, 404:
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Token",
+			fmt.Sprintf("Service token %s could not be read in organization %q (status %d). "+
+				"Check that the token belongs to this organization and has the read_service_tokens access.", serviceTokenID, request.Organization, res.StatusCode),
+		)
+		return
+	default:
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return
+	}
+	if !(res.Object != nil) {
+		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return
+	}
+
+	token := res.Object
+	data.Kind = types.StringValue(currentIdentityKindServiceToken)
+	data.ID = types.StringValue(token.ID)
+	data.Name = types.StringPointerValue(token.Name)
+	data.DisplayName = types.StringValue(token.DisplayName)
+	data.ExpiresAt = types.StringPointerValue(token.ExpiresAt)
+	data.LastUsedAt = types.StringPointerValue(token.LastUsedAt)
+	data.User = nil
+
+	data.Accesses = []tfTypes.CurrentIdentityAccess{}
+	data.AccessNames = []types.String{}
+	seen := make(map[string]bool)
+	for _, access := range token.ServiceTokenAccesses {
+		data.Accesses = append(data.Accesses, tfTypes.CurrentIdentityAccess{
+			Access:       types.StringValue(access.Access),
+			Description:  types.StringValue(access.Description),
+			ResourceID:   types.StringValue(access.ResourceID),
+			ResourceName: types.StringValue(access.ResourceName),
+			ResourceType: types.StringValue(access.ResourceType),
+		})
+		if !seen[access.Access] {
+			seen[access.Access] = true
+			data.AccessNames = append(data.AccessNames, types.StringValue(access.Access))
+		}
+	}
+}
+
+func (r *CurrentIdentityDataSource) readUser(ctx context.Context, data *CurrentIdentityDataSourceModel, resp *datasource.ReadResponse) {
+	res, err := r.client.Users.GetCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	if res.StatusCode != 200 {
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+		return
+	}
+	if !(res.Object != nil) {
+		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return
+	}
+
+	user := res.Object
+	data.Kind = types.StringValue(currentIdentityKindUser)
+	data.ID = types.StringValue(user.ID)
+	data.Name = types.StringValue(user.Name)
+	data.DisplayName = types.StringValue(user.DisplayName)
+	data.ExpiresAt = types.StringNull()
+	data.LastUsedAt = types.StringNull()
+	data.User = &tfTypes.CurrentIdentityUser{
+		DisplayName: types.StringValue(user.DisplayName),
+		Email:       types.StringValue(user.Email),
+		ID:          types.StringValue(user.ID),
+		Name:        types.StringValue(user.Name),
+	}
+
+	// OAuth scopes are not exposed for the current user, so there are no
+	// service token accesses to report.
+	data.Accesses = nil
+	data.AccessNames = nil
+}
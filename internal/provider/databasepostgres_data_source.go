@@ -333,10 +333,7 @@ func (r *DatabasePostgresDataSource) Read(ctx context.Context, req datasource.Re
 	}
 	res, err := r.client.Databases.GetPostgresDatabase(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -344,7 +341,7 @@ func (r *DatabasePostgresDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Config.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -347,10 +347,7 @@ func (r *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 	res, err := r.client.Databases.ListDatabases(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -358,7 +355,7 @@ func (r *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Config.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -377,10 +374,7 @@ func (r *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		res, err = res.Next()
 
 		if err != nil {
-			resp.Diagnostics.AddError("failed to retrieve next page of results", err.Error())
-			if res != nil && res.RawResponse != nil {
-				resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-			}
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 			return
 		}
 
@@ -363,10 +363,7 @@ func (r *DatabaseVitessDataSource) Read(ctx context.Context, req datasource.Read
 	}
 	res, err := r.client.Databases.GetVitessDatabase(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -374,7 +371,7 @@ func (r *DatabaseVitessDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Config.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
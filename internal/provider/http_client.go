@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// newProviderBaseTransport returns the transport wrapped by the provider
+// HTTP transport. It starts from a copy of http.DefaultTransport, so the
+// proxy and TLS settings only apply to this provider instance.
+func newProviderBaseTransport(data *PlanetscaleProviderModel) (*http.Transport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyURL := data.ProxyURL.ValueString(); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				"The proxy_url attribute must be an absolute URL such as http://proxy.example.com:3128.",
+			)
+		} else {
+			transport.Proxy = http.ProxyURL(u)
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	caCertPem := data.CaCertPem.ValueString()
+	caCertFile := data.CaCertFile.ValueString()
+	if caCertPem != "" || caCertFile != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if caCertPem != "" && !rootCAs.AppendCertsFromPEM([]byte(caCertPem)) {
+			diags.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid CA Certificate",
+				"The ca_cert_pem attribute does not contain any PEM encoded certificate.",
+			)
+		}
+
+		if caCertFile != "" {
+			pem, err := os.ReadFile(caCertFile)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Unable to Read CA Certificate File",
+					err.Error(),
+				)
+			} else if !rootCAs.AppendCertsFromPEM(pem) {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid CA Certificate",
+					"The file "+caCertFile+" does not contain any PEM encoded certificate.",
+				)
+			}
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if certPem, keyPem := data.ClientCertPem.ValueString(), data.ClientKeyPem.ValueString(); certPem != "" || keyPem != "" {
+		cert, err := tls.X509KeyPair([]byte(certPem), []byte(keyPem))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert_pem"),
+				"Invalid Client Certificate",
+				"The client_cert_pem and client_key_pem attributes must hold a matching PEM encoded certificate and private key: "+err.Error(),
+			)
+		} else {
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, diags
+}
+
+// providerHeaders returns the extra HTTP headers configured on the provider.
+// Authentication headers are set by the SDK and cannot be overridden.
+func providerHeaders(data *PlanetscaleProviderModel) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	headers := make(map[string]string, len(data.Headers))
+	for name, value := range data.Headers {
+		if textproto.CanonicalMIMEHeaderKey(name) == "Authorization" {
+			diags.AddAttributeError(
+				path.Root("headers").AtMapKey(name),
+				"Invalid Header",
+				"The Authorization header is set from the provider credentials and cannot be configured in headers.",
+			)
+			continue
+		}
+		headers[name] = value.ValueString()
+	}
+
+	return headers, diags
+}
+
+// providerRequestTimeout returns the configured per-request timeout, or zero
+// when none is set. The value is checked by the schema validator.
+func providerRequestTimeout(data *PlanetscaleProviderModel) time.Duration {
+	timeout, err := time.ParseDuration(data.RequestTimeout.ValueString())
+	if err != nil {
+		return 0
+	}
+	return timeout
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProviderBaseTransportTrustsCACertPem(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caCertPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	transport, diags := newProviderBaseTransport(&PlanetscaleProviderModel{
+		CaCertPem: types.StringValue(string(caCertPem)),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+	require.NotSame(t, http.DefaultTransport, transport)
+
+	res, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestNewProviderBaseTransportInvalidSettings(t *testing.T) {
+	t.Parallel()
+
+	_, diags := newProviderBaseTransport(&PlanetscaleProviderModel{
+		CaCertPem: types.StringValue("not a certificate"),
+		ProxyURL:  types.StringValue("proxy.example.com"),
+	})
+	require.Len(t, diags.Errors(), 2)
+}
+
+func TestProviderHeadersRejectsAuthorization(t *testing.T) {
+	t.Parallel()
+
+	headers, diags := providerHeaders(&PlanetscaleProviderModel{
+		Headers: map[string]types.String{
+			"X-Request-Source": types.StringValue("terraform"),
+			"authorization":    types.StringValue("token"),
+		},
+	})
+	require.Len(t, diags.Errors(), 1)
+	require.Equal(t, map[string]string{"X-Request-Source": "terraform"}, headers)
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+)
+
+// isInFlightResizeState reports whether a resize request in the given state
+// still blocks another resize of the same keyspace or branch.
+func isInFlightResizeState(state string) bool {
+	return state == "pending" || state == "queued" || state == "resizing"
+}
+
+// inFlightChange describes a keyspace or branch change that was still running
+// when Terraform looked at the resource.
+type inFlightChange struct {
+	// kind is a short description such as "resize" or "configuration change".
+	kind  string
+	state string
+	// actorID and actorName identify who started the change. They are empty
+	// for changes the API does not attribute, such as configuration changes.
+	actorID   string
+	actorName string
+}
+
+// startedOutsideTerraform reports whether the change was started by someone
+// other than the identity the provider authenticates as. Changes without an
+// actor are treated as external: the provider always waits for the changes
+// it starts, so one still in flight at plan time was not started by an
+// apply that ran to completion.
+func (c *inFlightChange) startedOutsideTerraform(currentActorID string) bool {
+	return c.actorID == "" || currentActorID == "" || c.actorID != currentActorID
+}
+
+func (c *inFlightChange) warning(resourceKind, name string) diag.Diagnostic {
+	by := ""
+	if c.actorName != "" {
+		by = fmt.Sprintf(" by %s", c.actorName)
+	}
+
+	return diag.NewWarningDiagnostic(
+		fmt.Sprintf("%s Change In Progress Outside Terraform", resourceKind),
+		fmt.Sprintf("A %s of %s %q was started outside of Terraform%s and is still %q. "+
+			"Applying this plan waits for it to finish before submitting the planned change, which may then overwrite it.",
+			c.kind, strings.ToLower(resourceKind), name, by, c.state),
+	)
+}
+
+// currentActorID returns the ID PlanetScale records as the actor of changes
+// made by the provider: the service token ID, or the user ID when
+// authenticating with an OAuth token. It returns an empty string when the
+// identity cannot be determined.
+func currentActorID(ctx context.Context, client *sdk.PlanetScale) string {
+	if serviceTokenID := client.ServiceTokenID(ctx); serviceTokenID != "" {
+		return serviceTokenID
+	}
+
+	res, err := client.Users.GetCurrentUser(ctx)
+	if err != nil || res == nil || res.StatusCode != 200 || res.Object == nil {
+		tflog.Debug(ctx, "Unable to determine the current PlanetScale identity")
+		return ""
+	}
+
+	return res.Object.ID
+}
+
+// warnInFlightKeyspaceChange warns when the keyspace has a change in flight
+// that was not started by Terraform, since the apply will wait for it first.
+func (r *VitessKeyspaceResource) warnInFlightKeyspaceChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var data *VitessKeyspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	change, err := r.findInFlightKeyspaceChange(ctx, data)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check for in-flight keyspace changes", map[string]interface{}{
+			"error": redactedError(err),
+		})
+		return
+	}
+	if change == nil || !change.startedOutsideTerraform(currentActorID(ctx, r.client)) {
+		return
+	}
+
+	resp.Diagnostics.Append(change.warning("Keyspace", data.Name.ValueString()))
+}
+
+// findInFlightKeyspaceChange returns the resize or configuration change the
+// keyspace is going through, or nil when the keyspace is idle.
+func (r *VitessKeyspaceResource) findInFlightKeyspaceChange(ctx context.Context, data *VitessKeyspaceResourceModel) (*inFlightChange, error) {
+	request, diags := data.ToOperationsGetKeyspaceRequest(ctx)
+	if diags.HasError() {
+		return nil, errors.New("invalid keyspace request")
+	}
+
+	res, err := r.client.DatabaseBranchKeyspaces.GetKeyspace(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || res.StatusCode != 200 || res.Object == nil {
+		return nil, fmt.Errorf("unexpected response retrieving keyspace %q", request.Keyspace)
+	}
+
+	state := keyspaceChangeState(res.Object)
+	if state == "" {
+		return nil, nil
+	}
+
+	resizes, err := r.client.KeyspaceResizes.ListKeyspaceResizeRequests(ctx, operations.ListKeyspaceResizeRequestsRequest{
+		Organization: request.Organization,
+		Database:     request.Database,
+		Branch:       request.Branch,
+		Keyspace:     request.Keyspace,
+	})
+	if err == nil && resizes != nil && resizes.StatusCode == 200 && resizes.Object != nil {
+		for _, resize := range resizes.Object.Data {
+			if isInFlightResizeState(resize.State) {
+				return &inFlightChange{
+					kind:      "resize",
+					state:     resize.State,
+					actorID:   resize.Actor.ID,
+					actorName: resize.Actor.DisplayName,
+				}, nil
+			}
+		}
+	}
+
+	return &inFlightChange{kind: "configuration change", state: state}, nil
+}
+
+// keyspaceChangeState names the change the keyspace is going through, or
+// returns an empty string when it has none in flight.
+func keyspaceChangeState(keyspace *operations.GetKeyspaceResponseBody) string {
+	switch {
+	case keyspace.Resizing:
+		return "resizing"
+	case keyspace.ResizePending:
+		return "resize_pending"
+	case keyspace.ResizeInProgress:
+		return "resize_in_progress"
+	case keyspace.ConfigChangeInProgress:
+		return "config_change_in_progress"
+	default:
+		return ""
+	}
+}
+
+// waitForInFlightKeyspaceChange waits for a resize or configuration change
+// that is already running on the keyspace, so that the planned change is
+// submitted once PlanetScale accepts it rather than rejected.
+func (r *VitessKeyspaceResource) waitForInFlightKeyspaceChange(ctx context.Context, data *VitessKeyspaceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	change, err := r.findInFlightKeyspaceChange(ctx, data)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if change == nil {
+		return diags
+	}
+
+	tflog.Info(ctx, "Waiting for in-flight keyspace change before updating", map[string]interface{}{
+		"keyspace": data.Name.ValueString(),
+		"change":   change.kind,
+		"state":    change.state,
+	})
+
+	request, requestDiags := data.ToOperationsGetKeyspaceRequest(ctx)
+	diags.Append(requestDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	progress := newWaitProgress(fmt.Sprintf("in-flight keyspace %s to finish", change.kind), typicalKeyspaceReadyDuration)
+	res, err := waitForIdleKeyspace(ctx, r.client, *request, withPollingBackoff, polling.WithAttemptHook(progress.Observe))
+	diags.Append(progress.Diagnostics()...)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+	}
+
+	return diags
+}
+
+// waitForIdleKeyspace polls the keyspace until no resize or configuration
+// change is in flight. The keyspace WaitForReady criteria do not look at
+// resize_in_progress, so the polling loop is driven here with the same
+// schedule and limits.
+func waitForIdleKeyspace(ctx context.Context, client *sdk.PlanetScale, request operations.GetKeyspaceRequest, opts ...polling.Option) (*operations.GetKeyspaceResponse, error) {
+	config, err := client.DatabaseBranchKeyspaces.GetKeyspaceWaitForReady()(opts...)
+	if err != nil {
+		return nil, err
+	}
+	config.Name = "WaitForIdle"
+
+	poller := polling.NewPoller(config)
+	if err := poller.Delay(ctx); err != nil {
+		return nil, err
+	}
+
+	for {
+		res, err := client.DatabaseBranchKeyspaces.GetKeyspace(ctx, request)
+		if err != nil || res == nil || res.StatusCode != 200 || res.Object == nil {
+			return res, err
+		}
+
+		state := keyspaceChangeState(res.Object)
+		if state == "" {
+			poller.Observe(ctx, res.StatusCode, "idle")
+			return res, nil
+		}
+		poller.Observe(ctx, res.StatusCode, state)
+
+		if err := poller.Wait(ctx); err != nil {
+			return res, err
+		}
+	}
+}
+
+// warnInFlightBranchResize warns when the branch has a resize in flight that
+// was not started by Terraform, since the apply will wait for it first.
+func (r *VitessBranchResource) warnInFlightBranchResize(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var data *VitessBranchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resize, err := r.findInFlightBranchResize(ctx, data)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to check for in-flight branch resizes", map[string]interface{}{
+			"error": redactedError(err),
+		})
+		return
+	}
+	if resize == nil {
+		return
+	}
+
+	change := &inFlightChange{
+		kind:      "resize",
+		state:     resize.State,
+		actorID:   resize.Actor.ID,
+		actorName: resize.Actor.DisplayName,
+	}
+	if !change.startedOutsideTerraform(currentActorID(ctx, r.client)) {
+		return
+	}
+
+	resp.Diagnostics.Append(change.warning("Branch", data.Name.ValueString()))
+}
+
+// findInFlightBranchResize returns the most recent resize request of the
+// branch that has not finished yet, or nil when there is none.
+func (r *VitessBranchResource) findInFlightBranchResize(ctx context.Context, data *VitessBranchResourceModel) (*operations.ListBranchResizeRequestsData, error) {
+	res, err := r.client.APIBranchResizes.ListBranchResizeRequests(ctx, operations.ListBranchResizeRequestsRequest{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+		Branch:       data.Name.ValueString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || res.StatusCode != 200 || res.Object == nil {
+		return nil, fmt.Errorf("unexpected response listing resizes of branch %q", data.Name.ValueString())
+	}
+
+	for _, resize := range res.Object.Data {
+		if isInFlightResizeState(resize.State) {
+			return &resize, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// waitForInFlightBranchResize waits for a resize that is already running on
+// the branch before the planned resize is submitted. A resize canceled while
+// waiting no longer blocks the update.
+func (r *VitessBranchResource) waitForInFlightBranchResize(ctx context.Context, data *VitessBranchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resize, err := r.findInFlightBranchResize(ctx, data)
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if resize == nil {
+		return diags
+	}
+
+	tflog.Info(ctx, "Waiting for in-flight branch resize before updating", map[string]interface{}{
+		"branch":    data.Name.ValueString(),
+		"resize_id": resize.ID,
+		"state":     resize.State,
+	})
+
+	progress := newWaitProgress("in-flight branch resize to finish", typicalBranchResizeDuration)
+	res, err := r.client.APIBranchResizes.GetBranchResizeRequest(ctx, operations.GetBranchResizeRequestRequest{
+		Organization:    data.Organization.ValueString(),
+		Database:        data.Database.ValueString(),
+		Branch:          data.Name.ValueString(),
+		ResizeRequestID: resize.ID,
+	}, operations.WithPolling(
+		r.client.APIBranchResizes.GetBranchResizeRequestWaitForResizeComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(progress.Observe),
+	))
+	diags.Append(progress.Diagnostics()...)
+
+	var failureErr *polling.FailureCriteriaError
+	if errors.As(err, &failureErr) {
+		tflog.Info(ctx, "In-flight branch resize was canceled", map[string]interface{}{
+			"resize_id": resize.ID,
+		})
+		return diags
+	}
+	if err != nil {
+		diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+		return diags
+	}
+	if res == nil {
+		diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return diags
+	}
+	if res.StatusCode != 200 {
+		diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlightChangeStartedOutsideTerraform(t *testing.T) {
+	t.Parallel()
+
+	resize := &inFlightChange{kind: "resize", state: "resizing", actorID: "token-1", actorName: "ci-token"}
+	require.False(t, resize.startedOutsideTerraform("token-1"))
+	require.True(t, resize.startedOutsideTerraform("token-2"))
+	require.True(t, resize.startedOutsideTerraform(""))
+
+	configChange := &inFlightChange{kind: "configuration change", state: "config_change_in_progress"}
+	require.True(t, configChange.startedOutsideTerraform("token-1"))
+
+	warning := resize.warning("Keyspace", "main")
+	require.Contains(t, warning.Detail(), `resize of keyspace "main" was started outside of Terraform by ci-token`)
+	require.Contains(t, warning.Detail(), `"resizing"`)
+}
+
+func TestIsInFlightResizeState(t *testing.T) {
+	t.Parallel()
+
+	for _, state := range []string{"pending", "queued", "resizing"} {
+		require.True(t, isInFlightResizeState(state), state)
+	}
+	for _, state := range []string{"completed", "canceled", ""} {
+		require.False(t, isInFlightResizeState(state), state)
+	}
+}
+
+func TestWaitForIdleKeyspace(t *testing.T) {
+	t.Parallel()
+
+	// The keyspace is ready throughout, but keeps a resize pending for three
+	// polls and then reports it in progress for one more.
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+		if err := expectRequest(r, http.MethodGet, "/organizations/org/databases/db/branches/main/keyspaces/app"); err != nil {
+			return err
+		}
+
+		call := calls.Add(1)
+		writeJSON(w, fmt.Sprintf(`{
+			"id": "ks-1",
+			"name": "app",
+			"ready": true,
+			"resizing": false,
+			"resize_pending": %t,
+			"resize_in_progress": %t,
+			"config_change_in_progress": false
+		}`, call <= 3, call == 4))
+		return nil
+	})
+
+	var states []string
+	res, err := waitForIdleKeyspace(context.Background(), client, operations.GetKeyspaceRequest{
+		Organization: "org",
+		Database:     "db",
+		Branch:       "main",
+		Keyspace:     "app",
+	},
+		polling.WithDelaySecondsOverride(0),
+		polling.WithIntervalSecondsOverride(0),
+		polling.WithAttemptHook(func(_ context.Context, attempt polling.Attempt) {
+			states = append(states, attempt.State)
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 200, res.StatusCode)
+	require.EqualValues(t, 5, calls.Load())
+	require.Equal(t, []string{"resize_pending", "resize_pending", "resize_pending", "resize_in_progress", "idle"}, states)
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+)
+
+// keyspaceResizeCancelTimeout bounds the cancellation request sent after an
+// interrupted apply, since the context of the apply itself is already done.
+const keyspaceResizeCancelTimeout = 30 * time.Second
+
+// waitForKeyspaceResize follows the given resize request until the API
+// reports it completed. While the resize rolls out, the per-shard rollout
+// status is reported as progress. Only the pending, queued and resizing states
+// count as in progress: a resize that is canceled, fails to roll out or
+// reaches any other state is returned as an error. A resize that has not
+// started yet is canceled when the apply is interrupted.
+func (r *VitessKeyspaceResource) waitForKeyspaceResize(ctx context.Context, data *VitessKeyspaceResourceModel, resizeID string, opts ...polling.Option) (diags diag.Diagnostics) {
+	progress := newWaitProgress("keyspace resize to complete", typicalBranchResizeDuration)
+	defer func() {
+		diags = append(progress.Diagnostics(), diags...)
+	}()
+
+	config, err := r.client.KeyspaceResizes.GetKeyspaceResizeRequestWaitForResizeComplete()(
+		append([]polling.Option{withPollingBackoff, polling.WithAttemptHook(progress.Observe)}, opts...)...,
+	)
+	if err != nil {
+		diags.AddError("failure to configure keyspace resize polling", err.Error())
+		return diags
+	}
+
+	request := operations.GetKeyspaceResizeRequestRequest{
+		Organization:    data.Organization.ValueString(),
+		Database:        data.Database.ValueString(),
+		Branch:          data.Branch.ValueString(),
+		Keyspace:        data.Name.ValueString(),
+		ResizeRequestID: resizeID,
+	}
+
+	poller := polling.NewPoller(config)
+	state := ""
+	for {
+		res, err := r.client.KeyspaceResizes.GetKeyspaceResizeRequest(ctx, request)
+		if err != nil {
+			if ctx.Err() != nil {
+				return r.interruptKeyspaceResize(ctx, request, state, ctx.Err())
+			}
+			diags.Append(apiInvokeErrorDiagnostics(ctx, nil, err)...)
+			return diags
+		}
+		if res == nil {
+			diags.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+			return diags
+		}
+		if res.StatusCode != 200 {
+			diags.Append(apiResponseDiagnostics(ctx, nil, res.RawResponse)...)
+			return diags
+		}
+		if res.Object == nil {
+			diags.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+			return diags
+		}
+
+		state = res.Object.ResizeRequestState
+		switch state {
+		case "completed":
+			poller.Observe(ctx, res.StatusCode, state)
+			return diags
+		case "canceled":
+			poller.Observe(ctx, res.StatusCode, state)
+			diags.AddError(
+				"Keyspace Resize Canceled",
+				fmt.Sprintf("Resize request %s of keyspace %q was canceled before it completed. "+
+					"The keyspace keeps its previous size; check the PlanetScale dashboard for who canceled it, then apply again.",
+					resizeID, request.Keyspace),
+			)
+			return diags
+		case "resizing":
+			rollout, failedShards := r.observeKeyspaceRollout(ctx, poller, request, res.Object)
+			if len(failedShards) > 0 {
+				diags.AddError(
+					"Keyspace Resize Failed",
+					fmt.Sprintf("Resize request %s of keyspace %q failed to roll out to shard(s) %v (keyspace rollout state %q). "+
+						"Check the keyspace in the PlanetScale dashboard before applying again.",
+						resizeID, request.Keyspace, failedShards, rollout),
+				)
+				return diags
+			}
+		case "pending", "queued":
+			poller.Observe(ctx, res.StatusCode, state)
+		default:
+			poller.Observe(ctx, res.StatusCode, state)
+			diags.AddError(
+				"Keyspace Resize Failed",
+				fmt.Sprintf("Resize request %s of keyspace %q stopped in state %q. "+
+					"Check the keyspace in the PlanetScale dashboard before applying again.",
+					resizeID, request.Keyspace, state),
+			)
+			return diags
+		}
+
+		if err := poller.Wait(ctx); err != nil {
+			if ctx.Err() != nil {
+				return r.interruptKeyspaceResize(ctx, request, state, err)
+			}
+			diags.AddError(
+				"Keyspace Resize Did Not Complete",
+				fmt.Sprintf("Waiting for resize request %s of keyspace %q: %s", resizeID, request.Keyspace, err),
+			)
+			return diags
+		}
+	}
+}
+
+// observeKeyspaceRollout records a polling attempt with the rollout progress
+// of the keyspace. It returns the keyspace rollout state and the shards whose
+// rollout failed. Rollout status is informational: when it cannot be
+// retrieved the attempt is recorded without a percentage.
+func (r *VitessKeyspaceResource) observeKeyspaceRollout(ctx context.Context, poller *polling.Poller, request operations.GetKeyspaceResizeRequestRequest, resize *operations.GetKeyspaceResizeRequestResponseBody) (string, []string) {
+	res, err := r.client.DatabaseBranchKeyspaces.GetKeyspaceRolloutStatus(ctx, operations.GetKeyspaceRolloutStatusRequest{
+		Organization: request.Organization,
+		Database:     request.Database,
+		Branch:       request.Branch,
+		Keyspace:     request.Keyspace,
+	})
+	if err != nil || res == nil || res.StatusCode != 200 || res.Object == nil {
+		tflog.Debug(ctx, "Keyspace rollout status unavailable", map[string]interface{}{
+			"keyspace": request.Keyspace,
+		})
+		poller.Observe(ctx, 200, resize.ResizeRequestState)
+		return "", nil
+	}
+
+	for _, shard := range res.Object.Shards {
+		tflog.Debug(ctx, "Keyspace shard rollout", map[string]interface{}{
+			"keyspace":                 request.Keyspace,
+			"shard":                    shard.Name,
+			"state":                    shard.State,
+			"last_rollout_started_at":  shard.LastRolloutStartedAt,
+			"last_rollout_finished_at": shard.LastRolloutFinishedAt,
+		})
+	}
+
+	percent, ok := keyspaceRolloutPercent(resize.StartedAt, res.Object.Shards)
+	if ok {
+		poller.ObserveProgress(ctx, res.StatusCode, resize.ResizeRequestState, percent)
+	} else {
+		poller.Observe(ctx, res.StatusCode, resize.ResizeRequestState)
+	}
+
+	var failed []string
+	for _, shard := range res.Object.Shards {
+		if isFailedRolloutState(shard.State) {
+			failed = append(failed, shard.Name)
+		}
+	}
+	if len(failed) == 0 && isFailedRolloutState(res.Object.State) {
+		failed = append(failed, "all")
+	}
+
+	return res.Object.State, failed
+}
+
+// keyspaceRolloutPercent returns the share of shards that finished rolling
+// out since the resize started. A shard counts as finished once its last
+// rollout finished after it started, and, when the resize reports a start
+// time, not before the resize started.
+func keyspaceRolloutPercent(resizeStartedAt *string, shards []operations.GetKeyspaceRolloutStatusShard) (float64, bool) {
+	if len(shards) == 0 {
+		return 0, false
+	}
+
+	var since time.Time
+	if resizeStartedAt != nil {
+		since, _ = time.Parse(time.RFC3339, *resizeStartedAt)
+	}
+
+	finished := 0
+	for _, shard := range shards {
+		finishedAt, err := time.Parse(time.RFC3339, shard.LastRolloutFinishedAt)
+		if err != nil {
+			continue
+		}
+		if startedAt, err := time.Parse(time.RFC3339, shard.LastRolloutStartedAt); err == nil && finishedAt.Before(startedAt) {
+			continue
+		}
+		if !since.IsZero() && finishedAt.Before(since) {
+			continue
+		}
+		finished++
+	}
+
+	return float64(finished) * 100 / float64(len(shards)), true
+}
+
+func isFailedRolloutState(state string) bool {
+	return state == "failed" || state == "error"
+}
+
+// interruptKeyspaceResize handles an apply interrupted while waiting on a
+// resize. A resize that is still pending or queued is canceled so that it
+// does not start after Terraform gave up on it; a resize that is already
+// rolling out is left to finish.
+func (r *VitessKeyspaceResource) interruptKeyspaceResize(ctx context.Context, request operations.GetKeyspaceResizeRequestRequest, state string, cause error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state != "pending" && state != "queued" && state != "" {
+		diags.AddError(
+			"Keyspace Resize Interrupted",
+			fmt.Sprintf("Stopped waiting for resize request %s of keyspace %q (%s). The resize was already %q and will keep running; "+
+				"run terraform refresh once it completes.", request.ResizeRequestID, request.Keyspace, cause, state),
+		)
+		return diags
+	}
+
+	cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), keyspaceResizeCancelTimeout)
+	defer cancel()
+
+	res, err := r.client.KeyspaceResizes.CancelKeyspaceResizeRequest(cancelCtx, operations.CancelKeyspaceResizeRequestRequest{
+		Organization: request.Organization,
+		Database:     request.Database,
+		Branch:       request.Branch,
+		Keyspace:     request.Keyspace,
+	})
+	if err == nil && res != nil && res.StatusCode != 204 {
+		err = errors.New(res.RawResponse.Status)
+	}
+	if err != nil {
+		diags.AddError(
+			"Keyspace Resize Interrupted",
+			fmt.Sprintf("Stopped waiting for resize request %s of keyspace %q (%s), and canceling the pending resize failed: %s. "+
+				"Cancel it from the PlanetScale dashboard if it should not run.", request.ResizeRequestID, request.Keyspace, cause, redactedError(err)),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, "Canceled pending keyspace resize after interrupt", map[string]interface{}{
+		"keyspace":  request.Keyspace,
+		"resize_id": request.ResizeRequestID,
+	})
+	diags.AddError(
+		"Keyspace Resize Interrupted",
+		fmt.Sprintf("Stopped waiting for resize request %s of keyspace %q (%s). The resize had not started yet and was canceled, "+
+			"so the keyspace keeps its previous size.", request.ResizeRequestID, request.Keyspace, cause),
+	)
+	return diags
+}
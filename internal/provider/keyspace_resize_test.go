@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyspaceRolloutPercent(t *testing.T) {
+	t.Parallel()
+
+	_, ok := keyspaceRolloutPercent(nil, nil)
+	require.False(t, ok)
+
+	startedAt := "2026-10-01T12:00:00Z"
+	shards := []operations.GetKeyspaceRolloutStatusShard{
+		{Name: "-80", LastRolloutStartedAt: "2026-10-01T12:01:00Z", LastRolloutFinishedAt: "2026-10-01T12:05:00Z", State: "completed"},
+		{Name: "80-", LastRolloutStartedAt: "2026-10-01T12:06:00Z", LastRolloutFinishedAt: "2026-10-01T11:00:00Z", State: "in_progress"},
+		{Name: "c0-", LastRolloutStartedAt: "2026-09-30T08:00:00Z", LastRolloutFinishedAt: "2026-09-30T08:10:00Z", State: "completed"},
+		{Name: "e0-", LastRolloutStartedAt: "", LastRolloutFinishedAt: "", State: "pending"},
+	}
+
+	percent, ok := keyspaceRolloutPercent(&startedAt, shards)
+	require.True(t, ok)
+	require.Equal(t, 25.0, percent)
+
+	percent, ok = keyspaceRolloutPercent(nil, shards)
+	require.True(t, ok)
+	require.Equal(t, 50.0, percent)
+}
+
+func TestWaitForKeyspaceResize(t *testing.T) {
+	t.Parallel()
+
+	const resizePath = "/organizations/org/databases/db/branches/main/keyspaces/ks/resizes/resize-1"
+
+	testCases := map[string]struct {
+		states    []string
+		wantError string
+	}{
+		"completed": {
+			states: []string{"completed"},
+		},
+		"queued then completed": {
+			states: []string{"queued", "pending", "completed"},
+		},
+		"canceled": {
+			states:    []string{"pending", "canceled"},
+			wantError: "was canceled before it completed",
+		},
+		"failed": {
+			states:    []string{"pending", "failed"},
+			wantError: `stopped in state "failed"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+				if err := expectRequest(r, http.MethodGet, resizePath); err != nil {
+					return err
+				}
+				call := int(calls.Add(1))
+				if call > len(tc.states) {
+					return errors.New("polled after the resize reached a final state")
+				}
+				writeJSON(w, `{"id": "resize-1", "state": "`+tc.states[call-1]+`"}`)
+				return nil
+			})
+
+			data := &VitessKeyspaceResourceModel{
+				Organization: types.StringValue("org"),
+				Database:     types.StringValue("db"),
+				Branch:       types.StringValue("main"),
+				Name:         types.StringValue("ks"),
+			}
+
+			r := &VitessKeyspaceResource{client: client}
+			diags := r.waitForKeyspaceResize(context.Background(), data, "resize-1", polling.WithIntervalSecondsOverride(0))
+
+			require.Equal(t, int32(len(tc.states)), calls.Load())
+			if tc.wantError == "" {
+				require.False(t, diags.HasError(), diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			require.Contains(t, diags.Errors()[0].Detail(), tc.wantError)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+)
+
+var (
+	_ resource.ResourceWithModifyPlan = &BranchRestoreResource{}
+	_ resource.ResourceWithModifyPlan = &PostgresBackupPolicyResource{}
+	_ resource.ResourceWithModifyPlan = &PostgresBouncerResource{}
+	_ resource.ResourceWithModifyPlan = &PostgresBranchBackupResource{}
+	_ resource.ResourceWithModifyPlan = &PostgresBranchResource{}
+	_ resource.ResourceWithModifyPlan = &PostgresBranchRoleResource{}
+	_ resource.ResourceWithModifyPlan = &PostgresRedactedBranchRoleResource{}
+	_ resource.ResourceWithModifyPlan = &SchemaRecommendationDismissalResource{}
+	_ resource.ResourceWithModifyPlan = &VitessBackupPolicyResource{}
+	_ resource.ResourceWithModifyPlan = &VitessBranchBackupResource{}
+	_ resource.ResourceWithModifyPlan = &VitessBranchPasswordResource{}
+	_ resource.ResourceWithModifyPlan = &VitessBranchResource{}
+	_ resource.ResourceWithModifyPlan = &VitessKeyspaceResource{}
+)
+
+// planModifier is one feature's part of a resource's ModifyPlan.
+type planModifier func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse)
+
+// modifyPlan fills in the provider defaults and then runs the given plan
+// modifiers in order. Modifiers that change the plan come before those that
+// only check it. Each modifier sees the plan left by the previous one, and
+// none runs once one has returned an error.
+func modifyPlan(ctx context.Context, client *sdk.PlanetScale, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, modifiers ...planModifier) {
+	applyProviderDefaults(ctx, client, req, resp)
+
+	for _, modifier := range modifiers {
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		req.Plan = resp.Plan
+
+		modifier(ctx, req, resp)
+	}
+}
+
+func (r *BranchRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp, r.checkRestoreSource)
+}
+
+func (r *PostgresBackupPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp, modifyBackupPolicyPlan)
+}
+
+func (r *PostgresBouncerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *PostgresBranchBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *PostgresBranchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp, warnDeleteDescendants(r.client), r.checkRestoreWindow)
+}
+
+func (r *PostgresBranchRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *PostgresRedactedBranchRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *SchemaRecommendationDismissalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *VitessBackupPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp, modifyBackupPolicyPlan)
+}
+
+func (r *VitessBranchBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *VitessBranchPasswordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp)
+}
+
+func (r *VitessBranchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp, warnDeleteDescendants(r.client), r.warnInFlightBranchResize)
+}
+
+func (r *VitessKeyspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlan(ctx, r.client, req, resp, r.warnInFlightKeyspaceChange)
+}
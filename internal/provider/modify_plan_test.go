@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyPlanRunsModifiersInOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewVitessBranchResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	s := schemaResp.Schema
+
+	plan := tfsdk.Plan{Schema: s, Raw: resourceObject(t, s, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "main"),
+	})}
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: plan.Raw},
+		Plan:   plan,
+		State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+	var ran []string
+	modifyPlan(ctx, nil, req, resp,
+		func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+			ran = append(ran, "rename")
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), types.StringValue("renamed"))...)
+		},
+		func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+			ran = append(ran, "fail")
+			var name types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+			require.Equal(t, "renamed", name.ValueString())
+			resp.Diagnostics.AddError("failed", "")
+		},
+		func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+			ran = append(ran, "skipped")
+		},
+	)
+
+	require.Equal(t, []string{"rename", "fail"}, ran)
+	require.True(t, resp.Diagnostics.HasError())
+}
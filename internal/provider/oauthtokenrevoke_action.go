@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &OauthTokenRevokeAction{}
+var _ action.ActionWithConfigure = &OauthTokenRevokeAction{}
+
+func NewOauthTokenRevokeAction() action.Action {
+	return &OauthTokenRevokeAction{}
+}
+
+// OauthTokenRevokeAction is the action implementation.
+type OauthTokenRevokeAction struct {
+	// Provider configured SDK client.
+	client *sdk.PlanetScale
+}
+
+// OauthTokenRevokeActionModel describes the action data model.
+type OauthTokenRevokeActionModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	Organization  types.String `tfsdk:"organization"`
+	TokenID       types.String `tfsdk:"token_id"`
+}
+
+// Metadata returns the action type name.
+func (r *OauthTokenRevokeAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_token_revoke"
+}
+
+// Schema defines the schema for the action.
+func (r *OauthTokenRevokeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Revokes an OAuth token issued by a PlanetScale OAuth application. Tokens that no longer exist are treated as already revoked.",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required:    true,
+				Description: `The ID of the OAuth application`,
+			},
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: `The name of the organization the OAuth application belongs to`,
+			},
+			"token_id": schema.StringAttribute{
+				Required:    true,
+				Description: `The ID of the OAuth application token`,
+			},
+		},
+	}
+}
+
+func (r *OauthTokenRevokeAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.PlanetScale)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *sdk.PlanetScale, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OauthTokenRevokeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data OauthTokenRevokeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := operations.DeleteOauthTokenRequest{
+		Organization:  data.Organization.ValueString(),
+		ApplicationID: data.ApplicationID.ValueString(),
+		TokenID:       data.TokenID.ValueString(),
+	}
+	res, err := r.client.OAuthApplications.DeleteOauthToken(ctx, request)
+	if err != nil {
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	switch res.StatusCode {
+	case 204:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Revoked OAuth token %s", request.TokenID),
+		})
+	case 404:
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("OAuth token %s not found, treating as already revoked", request.TokenID),
+		})
+	default:
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Config.Schema, res.RawResponse)...)
+		return
+	}
+}
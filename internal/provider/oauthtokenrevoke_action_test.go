@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOauthTokenRevokeActionInvoke(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status       int
+		body         string
+		wantProgress string
+		wantError    string
+		wantDetail   string
+	}{
+		"revoked": {
+			status:       http.StatusNoContent,
+			wantProgress: "Revoked OAuth token token-1",
+		},
+		"already revoked": {
+			status:       http.StatusNotFound,
+			body:         `{"code": "not_found", "message": "Not Found"}`,
+			wantProgress: "OAuth token token-1 not found, treating as already revoked",
+		},
+		"access denied": {
+			status:     http.StatusForbidden,
+			body:       `{"code": "forbidden", "message": "Missing delete_oauth_tokens access"}`,
+			wantError:  "PlanetScale Access Denied",
+			wantDetail: "Missing delete_oauth_tokens access (code: forbidden)",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &OauthTokenRevokeAction{
+				client: newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+					if err := expectRequest(r, http.MethodDelete, "/organizations/org/oauth-applications/app-1/tokens/token-1"); err != nil {
+						return err
+					}
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.body))
+					return nil
+				}),
+			}
+
+			ctx := context.Background()
+			var schemaResp action.SchemaResponse
+			a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			s := schemaResp.Schema
+			config := tfsdk.Config{
+				Schema: s,
+				Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"organization":   tftypes.NewValue(tftypes.String, "org"),
+					"application_id": tftypes.NewValue(tftypes.String, "app-1"),
+					"token_id":       tftypes.NewValue(tftypes.String, "token-1"),
+				}),
+			}
+
+			var progress []string
+			resp := &action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) {
+					progress = append(progress, event.Message)
+				},
+			}
+			a.Invoke(ctx, action.InvokeRequest{Config: config}, resp)
+
+			if tc.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				require.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tc.wantError)
+				require.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.wantDetail)
+				require.Empty(t, progress)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Equal(t, []string{tc.wantProgress}, progress)
+		})
+	}
+}
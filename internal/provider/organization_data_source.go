@@ -198,10 +198,7 @@ func (r *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 	res, err := r.client.Organizations.GetOrganization(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -209,7 +206,7 @@ func (r *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Config.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
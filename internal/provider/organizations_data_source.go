@@ -187,10 +187,7 @@ func (r *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 	res, err := r.client.Organizations.ListOrganizations(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -198,7 +195,7 @@ func (r *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Config.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -217,10 +214,7 @@ func (r *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 		res, err = res.Next()
 
 		if err != nil {
-			resp.Diagnostics.AddError("failed to retrieve next page of results", err.Error())
-			if res != nil && res.RawResponse != nil {
-				resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-			}
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
 			return
 		}
 
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+)
+
+// Typical durations of the operations the provider waits on. A wait that
+// runs past its typical duration is still allowed to finish, but reports a
+// warning so operators can decide whether to keep waiting or escalate.
+const (
+	typicalBranchReadyDuration     = 10 * time.Minute
+	typicalBranchChangeDuration    = 15 * time.Minute
+	typicalBranchResizeDuration    = 30 * time.Minute
+	typicalBranchPromotionDuration = 2 * time.Minute
+	typicalKeyspaceReadyDuration   = 20 * time.Minute
+	typicalBackupCompleteDuration  = time.Hour
+)
+
+// Waits back off from the interval in their polling configuration up to
+// pollingMaxIntervalFactor times that interval. Jitter keeps concurrent
+// applies from polling in lockstep.
+const (
+	pollingBackoffExponent   = 1.5
+	pollingMaxIntervalFactor = 6
+	pollingJitterFactor      = 0.2
+)
+
+// withPollingBackoff is a polling option that backs off between attempts.
+// The schemas size each wait as limitCount attempts intervalSeconds apart,
+// after delaySeconds. That fixed schedule becomes the timeout of the wait, so
+// backing off reduces how often the API is polled but not how long the
+// provider waits.
+func withPollingBackoff(cfg *polling.Config) error {
+	if cfg.IntervalSeconds == nil || *cfg.IntervalSeconds <= 0 || cfg.LimitCount == nil {
+		return nil
+	}
+
+	interval := *cfg.IntervalSeconds
+	timeout := interval * *cfg.LimitCount
+	if cfg.DelaySeconds != nil {
+		timeout += *cfg.DelaySeconds
+	}
+
+	for _, opt := range []polling.Option{
+		polling.WithBackoffExponentOverride(pollingBackoffExponent),
+		polling.WithMaxIntervalSecondsOverride(interval * pollingMaxIntervalFactor),
+		polling.WithJitterFactorOverride(pollingJitterFactor),
+		polling.WithTimeoutSecondsOverride(timeout),
+	} {
+		if err := opt(cfg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitProgress reports the progress of a single SDK wait. Each polling
+// attempt is logged at info level with the observed state, the elapsed time
+// and, where the API reports one, the completion percentage.
+type waitProgress struct {
+	operation string
+	typical   time.Duration
+	diags     diag.Diagnostics
+	warned    bool
+}
+
+func newWaitProgress(operation string, typical time.Duration) *waitProgress {
+	return &waitProgress{
+		operation: operation,
+		typical:   typical,
+	}
+}
+
+// Observe is the polling attempt hook of the wait.
+func (w *waitProgress) Observe(ctx context.Context, attempt polling.Attempt) {
+	elapsed := attempt.Elapsed.Round(time.Second)
+
+	fields := map[string]interface{}{
+		"operation":   w.operation,
+		"attempt":     attempt.Number,
+		"status_code": attempt.StatusCode,
+		"state":       attempt.State,
+		"elapsed":     elapsed.String(),
+	}
+	if attempt.Percent != nil {
+		fields["percent_complete"] = math.Round(*attempt.Percent)
+	}
+	if attempt.Timeout > 0 {
+		fields["timeout"] = attempt.Timeout.String()
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting for %s", w.operation), fields)
+
+	if w.warned || w.typical <= 0 || attempt.Elapsed <= w.typical {
+		return
+	}
+	w.warned = true
+
+	tflog.Warn(ctx, fmt.Sprintf("Waiting for %s is taking longer than usual", w.operation), fields)
+
+	state := attempt.State
+	if state == "" {
+		state = "unknown"
+	}
+	w.diags.AddWarning(
+		"Operation Taking Longer Than Usual",
+		fmt.Sprintf("Waiting for %s took longer than the typical %s. It was still in state %q after %s. "+
+			"PlanetScale may be under load, so the provider kept waiting; if this keeps happening, check the status page or contact PlanetScale support.",
+			w.operation, w.typical, state, elapsed),
+	)
+}
+
+// Diagnostics returns the warnings raised while waiting.
+func (w *waitProgress) Diagnostics() diag.Diagnostics {
+	return w.diags
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitProgressWarnsOncePastTypicalDuration(t *testing.T) {
+	t.Parallel()
+
+	progress := newWaitProgress("branch resize to complete", time.Minute)
+
+	progress.Observe(context.Background(), polling.Attempt{Number: 1, StatusCode: 200, State: "pending", Elapsed: 30 * time.Second})
+	require.Empty(t, progress.Diagnostics())
+
+	percent := 40.0
+	progress.Observe(context.Background(), polling.Attempt{Number: 2, StatusCode: 200, State: "resizing", Percent: &percent, Elapsed: 2 * time.Minute})
+	progress.Observe(context.Background(), polling.Attempt{Number: 3, StatusCode: 200, State: "resizing", Elapsed: 3 * time.Minute})
+
+	diags := progress.Diagnostics()
+	require.Len(t, diags, 1)
+	require.False(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "branch resize to complete")
+	require.Contains(t, diags[0].Detail(), `"resizing" after 2m0s`)
+}
+
+func TestWithPollingBackoffKeepsScheduleCeiling(t *testing.T) {
+	t.Parallel()
+
+	delaySeconds, intervalSeconds, limitCount := 5, 5, 360
+	config := &polling.Config{
+		DelaySeconds:    &delaySeconds,
+		IntervalSeconds: &intervalSeconds,
+		LimitCount:      &limitCount,
+	}
+	require.NoError(t, withPollingBackoff(config))
+
+	require.Equal(t, 1.5, *config.BackoffExponent)
+	require.Equal(t, 30, *config.MaxIntervalSeconds)
+	require.Equal(t, 0.2, *config.JitterFactor)
+	require.Equal(t, 5+5*360, *config.TimeoutSeconds)
+
+	unbounded := &polling.Config{IntervalSeconds: &intervalSeconds}
+	require.NoError(t, withPollingBackoff(unbounded))
+	require.Nil(t, unbounded.TimeoutSeconds)
+	require.Nil(t, unbounded.BackoffExponent)
+}
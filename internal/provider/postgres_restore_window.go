@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+)
+
+// errNoRestorableBackup is returned when a branch has no backup a
+// point-in-time restore could start from.
+var errNoRestorableBackup = errors.New("the branch has no successful backup to restore from")
+
+// postgresRestoreWindow is the range of times a Postgres branch can be
+// restored to. The API does not report it directly: WAL is replayed on top of
+// a base backup, so the window starts when the oldest successful backup that
+// is still retained completed, and ends at the current time.
+type postgresRestoreWindow struct {
+	earliest       time.Time
+	earliestBackup string
+	latest         time.Time
+}
+
+// check returns an error describing why point cannot be restored to.
+func (w *postgresRestoreWindow) check(point time.Time) error {
+	if point.Before(w.earliest) {
+		return fmt.Errorf("%s is before the earliest restorable time %s (backup %s)",
+			point.Format(time.RFC3339), w.earliest.Format(time.RFC3339), w.earliestBackup)
+	}
+	if point.After(w.latest) {
+		return fmt.Errorf("%s is after the latest restorable time %s",
+			point.Format(time.RFC3339), w.latest.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// getPostgresRestoreWindow computes the restorable window of a branch from its
+// successful backups, as of now.
+func getPostgresRestoreWindow(ctx context.Context, client *sdk.PlanetScale, organization, database, branch string, now time.Time) (*postgresRestoreWindow, error) {
+	res, err := client.Backups.ListPostgresBranchBackups(ctx, operations.ListPostgresBranchBackupsRequest{
+		Organization: organization,
+		Database:     database,
+		Branch:       branch,
+		State:        operations.ListPostgresBranchBackupsQueryParamStateSuccess.ToPointer(),
+	})
+
+	var window *postgresRestoreWindow
+	for {
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			break
+		}
+		if res.StatusCode != 200 || res.Object == nil {
+			return nil, fmt.Errorf("unexpected response listing backups of branch %q: %s", branch, res.RawResponse.Status)
+		}
+
+		for _, backup := range res.Object.Data {
+			completedAt, ok := restorableBackupTime(backup, now)
+			if !ok {
+				continue
+			}
+			if window == nil || completedAt.Before(window.earliest) {
+				window = &postgresRestoreWindow{earliest: completedAt, earliestBackup: backup.ID}
+			}
+		}
+
+		res, err = res.Next()
+	}
+
+	if window == nil {
+		return nil, errNoRestorableBackup
+	}
+	window.latest = now
+	return window, nil
+}
+
+// restorableBackupTime returns when the backup completed, if a restore can
+// still start from it.
+func restorableBackupTime(backup operations.ListPostgresBranchBackupsData, now time.Time) (time.Time, bool) {
+	if backup.State != "success" || backup.DeletedAt != nil || backup.CompletedAt == nil {
+		return time.Time{}, false
+	}
+	if backup.ExpiresAt != nil {
+		if expiresAt, err := time.Parse(time.RFC3339, *backup.ExpiresAt); err == nil && !expiresAt.After(now) {
+			return time.Time{}, false
+		}
+	}
+
+	completedAt, err := time.Parse(time.RFC3339, *backup.CompletedAt)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return completedAt, true
+}
+
+// checkPostgresRestorePoint checks a planned restore point against the
+// restorable window of the branch it is restored from. The window is derived
+// from the backup list rather than reported by the API, so a restore point
+// outside it, or a branch without a successful backup, is only a warning: the
+// API validates the restore point again when the branch is created.
+func checkPostgresRestorePoint(ctx context.Context, client *sdk.PlanetScale, organization, database, branch string, restorePoint types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	point, err := time.Parse(time.RFC3339, restorePoint.ValueString())
+	if err != nil {
+		// Reported by the RFC3339 validator.
+		return diags
+	}
+
+	if branch == "" {
+		res, err := client.Databases.GetPostgresDatabase(ctx, operations.GetPostgresDatabaseRequest{
+			Organization: organization,
+			Database:     database,
+		})
+		if err == nil && (res == nil || res.StatusCode != 200 || res.Object == nil) {
+			err = errors.New("unexpected response from API")
+		}
+		if err != nil {
+			diags.AddAttributeWarning(
+				path.Root("restore_point"),
+				"Unable to Check Restore Point",
+				fmt.Sprintf("Looking up the default branch of database %q failed: %s", database, redactedError(err)),
+			)
+			return diags
+		}
+		branch = res.Object.DefaultBranch
+	}
+
+	window, err := getPostgresRestoreWindow(ctx, client, organization, database, branch, time.Now().UTC())
+	if errors.Is(err, errNoRestorableBackup) {
+		diags.AddAttributeWarning(
+			path.Root("restore_point"),
+			"Restore Point May Be Outside Restorable Window",
+			fmt.Sprintf("Branch %q may not be restorable to a point in time: %s. Creating the branch fails if the API agrees.", branch, err),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("restore_point"),
+			"Unable to Check Restore Point",
+			fmt.Sprintf("Looking up the restorable window of branch %q failed: %s", branch, redactedError(err)),
+		)
+		return diags
+	}
+
+	if err := window.check(point); err != nil {
+		diags.AddAttributeWarning(
+			path.Root("restore_point"),
+			"Restore Point May Be Outside Restorable Window",
+			fmt.Sprintf("Branch %q may not be restorable to %s. Creating the branch fails if the API agrees.", branch, err),
+		)
+	}
+
+	return diags
+}
+
+// checkRestoreWindow checks a new restore point against the restorable
+// window of the parent branch, so that a restore point whose WAL is likely
+// no longer retained is flagged in the plan rather than only when the branch
+// is created.
+func (r *PostgresBranchResource) checkRestoreWindow(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *PostgresBranchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.RestorePoint.IsNull() || plan.RestorePoint.IsUnknown() ||
+		plan.Organization.IsUnknown() || plan.Database.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state *PostgresBranchResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.RestorePoint.Equal(plan.RestorePoint) {
+			return
+		}
+	}
+
+	// An unknown parent_branch that is not configured defaults to the
+	// default branch of the database. One that is configured is only known
+	// after apply, and the window of the default branch says nothing about it.
+	parentBranch := ""
+	if plan.ParentBranch.IsUnknown() {
+		var configured types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parent_branch"), &configured)...)
+		if resp.Diagnostics.HasError() || !configured.IsNull() {
+			return
+		}
+	} else {
+		parentBranch = plan.ParentBranch.ValueString()
+	}
+
+	resp.Diagnostics.Append(checkPostgresRestorePoint(ctx, r.client, plan.Organization.ValueString(), plan.Database.ValueString(), parentBranch, plan.RestorePoint)...)
+}
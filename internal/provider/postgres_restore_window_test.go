@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPostgresRestoreWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	newClient := func(pages ...string) *sdk.PlanetScale {
+		return newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+			if err := expectRequest(r, http.MethodGet, "/organizations/org/databases/db/branches/main/backups"); err != nil {
+				return err
+			}
+			if state := r.URL.Query().Get("state"); state != "success" {
+				return fmt.Errorf("expected state=success, got %q", state)
+			}
+
+			data := "[]"
+			switch r.URL.Query().Get("page") {
+			case "", "1":
+				data = pages[0]
+			case "2":
+				if len(pages) > 1 {
+					data = pages[1]
+				}
+			}
+
+			writeJSON(w, `{"type": "list", "current_page": 1, "data": `+data+`}`)
+			return nil
+		})
+	}
+
+	client := newClient(
+		`[
+			{"id": "newest", "state": "success", "completed_at": "2026-03-09T00:00:00Z"},
+			{"id": "expired", "state": "success", "completed_at": "2026-02-01T00:00:00Z", "expires_at": "2026-03-01T00:00:00Z"}
+		]`,
+		`[
+			{"id": "deleted", "state": "success", "completed_at": "2026-02-15T00:00:00Z", "deleted_at": "2026-03-02T00:00:00Z"},
+			{"id": "oldest", "state": "success", "completed_at": "2026-03-03T00:00:00Z", "expires_at": "2026-04-03T00:00:00Z"}
+		]`,
+	)
+
+	window, err := getPostgresRestoreWindow(context.Background(), client, "org", "db", "main", now)
+	require.NoError(t, err)
+	require.Equal(t, "oldest", window.earliestBackup)
+	require.Equal(t, time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), window.earliest)
+	require.Equal(t, now, window.latest)
+
+	require.NoError(t, window.check(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)))
+	require.ErrorContains(t, window.check(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)), "before the earliest restorable time")
+	require.ErrorContains(t, window.check(now.Add(time.Hour)), "after the latest restorable time")
+
+	_, err = getPostgresRestoreWindow(context.Background(), newClient(`[]`), "org", "db", "main", now)
+	require.ErrorIs(t, err, errNoRestorableBackup)
+}
+
+func TestCheckPostgresRestorePointWarns(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		backups     string
+		wantWarning string
+	}{
+		"inside the window": {
+			backups: `[{"id": "b1", "state": "success", "completed_at": "2020-01-01T00:00:00Z"}]`,
+		},
+		"before the window": {
+			backups:     `[{"id": "b1", "state": "success", "completed_at": "2099-01-01T00:00:00Z"}]`,
+			wantWarning: "before the earliest restorable time",
+		},
+		"no successful backup": {
+			backups:     `[]`,
+			wantWarning: errNoRestorableBackup.Error(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+				if err := expectRequest(r, http.MethodGet, "/organizations/org/databases/db/branches/main/backups"); err != nil {
+					return err
+				}
+				data := tc.backups
+				if page := r.URL.Query().Get("page"); page != "" && page != "1" {
+					data = "[]"
+				}
+				writeJSON(w, `{"type": "list", "current_page": 1, "data": `+data+`}`)
+				return nil
+			})
+
+			diags := checkPostgresRestorePoint(context.Background(), client, "org", "db", "main", types.StringValue("2024-06-01T00:00:00Z"))
+			require.False(t, diags.HasError(), diags)
+			if tc.wantWarning == "" {
+				require.Empty(t, diags)
+				return
+			}
+			require.Len(t, diags, 1)
+			require.Contains(t, diags[0].Detail(), tc.wantWarning)
+		})
+	}
+}
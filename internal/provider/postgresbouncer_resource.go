@@ -79,8 +79,12 @@ func (r *PostgresBouncerResource) Schema(ctx context.Context, req resource.Schem
 				Description: `Branch name from ` + "`" + `list_branches` + "`" + `. Example: ` + "`" + `main` + "`" + `.`,
 			},
 			"database": schema.StringAttribute{
-				Required:    true,
-				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -98,8 +102,12 @@ func (r *PostgresBouncerResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"organization": schema.StringAttribute{
-				Required:    true,
-				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"parameters": schema.MapAttribute{
 				Computed: true,
@@ -183,10 +191,7 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 	}
 	res, err := r.client.Bouncers.CreateBouncer(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -194,7 +199,7 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -220,10 +225,7 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 	}
 	res1, err := r.client.Bouncers.ApplyPostgresBouncerTerraformChanges(ctx, *request1)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -234,7 +236,7 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 	case 200, 204:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -247,6 +249,12 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	resp.Diagnostics.Append(r.waitForBouncerResize(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -260,10 +268,7 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 	}
 	res2, err := r.client.Bouncers.GetPostgresBouncerTerraformState(ctx, *request2)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res2 != nil && res2.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res2.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res2 == nil {
@@ -271,7 +276,7 @@ func (r *PostgresBouncerResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	if res2.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res2.StatusCode), debugResponse(res2.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
 		return
 	}
 	if !(res2.Object != nil) {
@@ -320,22 +325,23 @@ func (r *PostgresBouncerResource) Read(ctx context.Context, req resource.ReadReq
 	}
 	res, err := r.client.Bouncers.GetPostgresBouncerTerraformState(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundResource(ctx, resp)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundResource(ctx, resp)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -374,10 +380,7 @@ func (r *PostgresBouncerResource) Update(ctx context.Context, req resource.Updat
 	}
 	res, err := r.client.Bouncers.ApplyPostgresBouncerTerraformChanges(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -388,7 +391,7 @@ func (r *PostgresBouncerResource) Update(ctx context.Context, req resource.Updat
 	case 200, 204:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -401,6 +404,12 @@ func (r *PostgresBouncerResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	resp.Diagnostics.Append(r.waitForBouncerResize(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -414,10 +423,7 @@ func (r *PostgresBouncerResource) Update(ctx context.Context, req resource.Updat
 	}
 	res1, err := r.client.Bouncers.GetPostgresBouncerTerraformState(ctx, *request1)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -425,7 +431,7 @@ func (r *PostgresBouncerResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -474,10 +480,7 @@ func (r *PostgresBouncerResource) Delete(ctx context.Context, req resource.Delet
 	}
 	res, err := r.client.Bouncers.DeleteBouncer(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -488,7 +491,7 @@ func (r *PostgresBouncerResource) Delete(ctx context.Context, req resource.Delet
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
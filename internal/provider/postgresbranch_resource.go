@@ -20,6 +20,8 @@ import (
 	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
+	"github.com/planetscale/terraform-provider-planetscale/internal/validators"
 	custom_stringvalidators "github.com/planetscale/terraform-provider-planetscale/internal/validators/stringvalidators"
 )
 
@@ -54,6 +56,7 @@ type PostgresBranchResourceModel struct {
 	Organization       types.String                         `tfsdk:"organization"`
 	Parameters         map[string]map[string]types.String   `tfsdk:"parameters"`
 	ParentBranch       types.String                         `tfsdk:"parent_branch"`
+	Production         types.Bool                           `tfsdk:"production"`
 	Ready              types.Bool                           `tfsdk:"ready"`
 	Region             types.String                         `tfsdk:"region"`
 	RegionData         *tfTypes.GetPostgresBranchRegionData `tfsdk:"region_data"`
@@ -96,17 +99,21 @@ func (r *PostgresBranchResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"database": schema.StringAttribute{
-				Required:    true,
-				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"delete_descendants": schema.BoolAttribute{
 				Optional:    true,
-				Description: `If true, recursively delete all descendant branches along with this branch`,
+				Description: `If true, recursively delete all descendant branches along with this branch. Plans that destroy or replace the branch list the descendants that would be deleted`,
 			},
 			"deletion_protected": schema.BoolAttribute{
 				Computed:    true,
 				Optional:    true,
-				Description: `Whether deletion protection is enabled for the branch`,
+				Description: `Whether deletion protection is enabled for the branch. A protected branch is not destroyed or replaced by Terraform, and a protected branch deleted outside of Terraform is reported as an error instead of being recreated.`,
 			},
 			"html_url": schema.StringAttribute{
 				Computed:    true,
@@ -128,8 +135,12 @@ func (r *PostgresBranchResource) Schema(ctx context.Context, req resource.Schema
 				Description: `The name of the branch to create`,
 			},
 			"organization": schema.StringAttribute{
-				Required:    true,
-				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"parameters": schema.MapAttribute{
 				Computed: true,
@@ -151,6 +162,11 @@ func (r *PostgresBranchResource) Schema(ctx context.Context, req resource.Schema
 				},
 				Description: `The name of the parent branch. Defaults to the database's default branch if not provided. Requires replacement if changed.`,
 			},
+			"production": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: `Whether the branch is a production branch. Changing it promotes or demotes the branch in place`,
+			},
 			"ready": schema.BoolAttribute{
 				Computed:    true,
 				Description: `Whether or not the branch is ready to serve queries`,
@@ -188,6 +204,9 @@ func (r *PostgresBranchResource) Schema(ctx context.Context, req resource.Schema
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					validators.IsRFC3339(),
+				},
 				Description: `Restore from a point-in-time recovery timestamp (e.g. 2023-01-01T00:00:00Z). Available only for PostgreSQL databases. Requires replacement if changed.`,
 			},
 			"state": schema.StringAttribute{
@@ -248,10 +267,7 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 	}
 	res, err := r.client.DatabaseBranches.CreatePostgresBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -259,7 +275,7 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	if res.StatusCode != 201 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -284,16 +300,17 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	getPostgresBranchProgress := newWaitProgress("Postgres branch to be ready", typicalBranchReadyDuration)
 	getPostgresBranchOptions := make([]operations.Option, 0, 1)
 	getPostgresBranchOptions = append(getPostgresBranchOptions, operations.WithPolling(
 		r.client.DatabaseBranches.GetPostgresBranchWaitForReady(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getPostgresBranchProgress.Observe),
 	))
 	res1, err := r.client.DatabaseBranches.GetPostgresBranch(ctx, *request1, getPostgresBranchOptions...)
+	resp.Diagnostics.Append(getPostgresBranchProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -301,7 +318,7 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -316,6 +333,18 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 
 	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.changeProduction(ctx, data, plannedProductionChange(data.Production, res1.Object.Production))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -327,10 +356,7 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 	}
 	res2, err := r.client.BranchChanges.ApplyPostgresBranchTerraformChanges(ctx, *request2)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res2 != nil && res2.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res2.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res2 == nil {
@@ -341,7 +367,7 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 	case 200, 204:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res2.StatusCode), debugResponse(res2.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
 		return
 	}
 	if !(res2.Object != nil) {
@@ -366,16 +392,17 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	getBranchChangeRequestProgress := newWaitProgress("branch change request to complete", typicalBranchChangeDuration)
 	getBranchChangeRequestOptions := make([]operations.Option, 0, 1)
 	getBranchChangeRequestOptions = append(getBranchChangeRequestOptions, operations.WithPolling(
 		r.client.BranchChanges.GetBranchChangeRequestWaitForChangeRequestComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getBranchChangeRequestProgress.Observe),
 	))
 	res3, err := r.client.BranchChanges.GetBranchChangeRequest(ctx, *request3, getBranchChangeRequestOptions...)
+	resp.Diagnostics.Append(getBranchChangeRequestProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res3 != nil && res3.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res3.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res3 == nil {
@@ -383,7 +410,7 @@ func (r *PostgresBranchResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	if res3.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res3.StatusCode), debugResponse(res3.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res3.RawResponse)...)
 		return
 	}
 	if !(res3.Object != nil) {
@@ -432,22 +459,23 @@ func (r *PostgresBranchResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 	res, err := r.client.DatabaseBranches.GetPostgresBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundProtectedResource(ctx, resp, data.DeletionProtected)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundProtectedResource(ctx, resp, data.DeletionProtected)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -486,10 +514,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 	}
 	res, err := r.client.DatabaseBranches.UpdatePostgresBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -497,7 +522,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -523,10 +548,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 	}
 	res1, err := r.client.BranchChanges.ApplyPostgresBranchTerraformChanges(ctx, *request1)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -537,7 +559,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 	case 200, 204:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -562,16 +584,17 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	getBranchChangeRequestProgress := newWaitProgress("branch change request to complete", typicalBranchChangeDuration)
 	getBranchChangeRequestOptions := make([]operations.Option, 0, 1)
 	getBranchChangeRequestOptions = append(getBranchChangeRequestOptions, operations.WithPolling(
 		r.client.BranchChanges.GetBranchChangeRequestWaitForChangeRequestComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getBranchChangeRequestProgress.Observe),
 	))
 	res2, err := r.client.BranchChanges.GetBranchChangeRequest(ctx, *request2, getBranchChangeRequestOptions...)
+	resp.Diagnostics.Append(getBranchChangeRequestProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res2 != nil && res2.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res2.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res2 == nil {
@@ -579,7 +602,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	if res2.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res2.StatusCode), debugResponse(res2.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
 		return
 	}
 	if !(res2.Object != nil) {
@@ -605,10 +628,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 	}
 	res3, err := r.client.DatabaseBranches.GetPostgresBranch(ctx, *request3)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res3 != nil && res3.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res3.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res3 == nil {
@@ -616,7 +636,7 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	if res3.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res3.StatusCode), debugResponse(res3.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res3.RawResponse)...)
 		return
 	}
 	if !(res3.Object != nil) {
@@ -635,6 +655,18 @@ func (r *PostgresBranchResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resp.Diagnostics.Append(r.changeProduction(ctx, data, plannedProductionChange(data.Production, res3.Object.Production))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -657,6 +689,11 @@ func (r *PostgresBranchResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if data.DeletionProtected.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectedBranchError(data.Name)...)
+		return
+	}
+
 	request, requestDiags := data.ToOperationsDeletePostgresBranchRequest(ctx)
 	resp.Diagnostics.Append(requestDiags...)
 
@@ -665,10 +702,7 @@ func (r *PostgresBranchResource) Delete(ctx context.Context, req resource.Delete
 	}
 	res, err := r.client.DatabaseBranches.DeletePostgresBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -679,7 +713,7 @@ func (r *PostgresBranchResource) Delete(ctx context.Context, req resource.Delete
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
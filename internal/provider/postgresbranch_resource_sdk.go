@@ -90,6 +90,7 @@ func (r *PostgresBranchResourceModel) RefreshFromOperationsGetPostgresBranchResp
 			}
 		}
 		r.ParentBranch = types.StringPointerValue(resp.ParentBranch)
+		r.Production = types.BoolValue(resp.Production)
 		r.Ready = types.BoolValue(resp.Ready)
 		r.RegionData = &tfTypes.GetPostgresBranchRegionData{}
 		r.RegionData.ID = types.StringValue(resp.RegionData.ID)
@@ -22,6 +22,7 @@ import (
 	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -50,6 +51,7 @@ type PostgresBranchBackupResourceModel struct {
 	Emergency            types.Bool                                     `tfsdk:"emergency"`
 	EstimatedStorageCost types.Float64                                  `tfsdk:"estimated_storage_cost"`
 	ExpiresAt            types.String                                   `tfsdk:"expires_at"`
+	ForceDelete          types.Bool                                     `tfsdk:"force_delete"`
 	ID                   types.String                                   `tfsdk:"id"`
 	Name                 types.String                                   `tfsdk:"name"`
 	Organization         types.String                                   `tfsdk:"organization"`
@@ -101,11 +103,12 @@ func (r *PostgresBranchBackupResource) Schema(ctx context.Context, req resource.
 				Description: `When the backup completed`,
 			},
 			"database": schema.StringAttribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `The name of the database the branch belongs to. Requires replacement if changed.`,
+				Description: `The name of the database the branch belongs to. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"database_branch": schema.SingleNestedAttribute{
 				Computed: true,
@@ -139,6 +142,10 @@ func (r *PostgresBranchBackupResource) Schema(ctx context.Context, req resource.
 				Computed:    true,
 				Description: `When the backup expires`,
 			},
+			"force_delete": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Whether to delete the backup even if it is protected. Protection is removed before the backup is deleted.`,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -156,15 +163,17 @@ func (r *PostgresBranchBackupResource) Schema(ctx context.Context, req resource.
 				Description: `Name for the backup. Requires replacement if changed.`,
 			},
 			"organization": schema.StringAttribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `The name of the organization the branch belongs to. Requires replacement if changed.`,
+				Description: `The name of the organization the branch belongs to. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"protected": schema.BoolAttribute{
 				Computed:    true,
-				Description: `Whether or not the backup is protected from deletion`,
+				Optional:    true,
+				Description: `Whether or not the backup is protected from deletion. Can be changed without replacing the backup. A protected backup is only deleted when ` + "`" + `force_delete` + "`" + ` is set.`,
 			},
 			"pvc_size": schema.Int64Attribute{
 				Computed:    true,
@@ -259,10 +268,7 @@ func (r *PostgresBranchBackupResource) Create(ctx context.Context, req resource.
 	}
 	res, err := r.client.Backups.CreatePostgresBranchBackup(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -270,7 +276,7 @@ func (r *PostgresBranchBackupResource) Create(ctx context.Context, req resource.
 		return
 	}
 	if res.StatusCode != 201 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -295,16 +301,17 @@ func (r *PostgresBranchBackupResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	getPostgresBranchBackupProgress := newWaitProgress("backup to complete", typicalBackupCompleteDuration)
 	getPostgresBranchBackupOptions := make([]operations.Option, 0, 1)
 	getPostgresBranchBackupOptions = append(getPostgresBranchBackupOptions, operations.WithPolling(
 		r.client.Backups.GetPostgresBranchBackupWaitForComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getPostgresBranchBackupProgress.Observe),
 	))
 	res1, err := r.client.Backups.GetPostgresBranchBackup(ctx, *request1, getPostgresBranchBackupOptions...)
+	resp.Diagnostics.Append(getPostgresBranchBackupProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -312,7 +319,7 @@ func (r *PostgresBranchBackupResource) Create(ctx context.Context, req resource.
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -330,6 +337,42 @@ func (r *PostgresBranchBackupResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data.Protected.ValueBool() && !res1.Object.Protected {
+		request2, request2Diags := data.ToOperationsUpdatePostgresBranchBackupRequest(ctx)
+		resp.Diagnostics.Append(request2Diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res2, err := r.client.Backups.UpdatePostgresBranchBackup(ctx, *request2)
+		if err != nil {
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
+			return
+		}
+		if res2 == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res2))
+			return
+		}
+		if res2.StatusCode != 200 {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
+			return
+		}
+		if !(res2.Object != nil) {
+			resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res2.RawResponse))
+			return
+		}
+		resp.Diagnostics.Append(data.RefreshFromOperationsUpdatePostgresBranchBackupResponseBody(ctx, res2.Object)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -361,22 +404,23 @@ func (r *PostgresBranchBackupResource) Read(ctx context.Context, req resource.Re
 	}
 	res, err := r.client.Backups.GetPostgresBranchBackup(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundResource(ctx, resp)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundResource(ctx, resp)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -407,7 +451,40 @@ func (r *PostgresBranchBackupResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	// Not Implemented; all attributes marked as RequiresReplace
+	request, requestDiags := data.ToOperationsUpdatePostgresBranchBackupRequest(ctx)
+	resp.Diagnostics.Append(requestDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := r.client.Backups.UpdatePostgresBranchBackup(ctx, *request)
+	if err != nil {
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	if res.StatusCode != 200 {
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
+		return
+	}
+	if !(res.Object != nil) {
+		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return
+	}
+	resp.Diagnostics.Append(data.RefreshFromOperationsUpdatePostgresBranchBackupResponseBody(ctx, res.Object)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -431,6 +508,12 @@ func (r *PostgresBranchBackupResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(r.unprotectForDelete(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, requestDiags := data.ToOperationsDeletePostgresBranchBackupRequest(ctx)
 	resp.Diagnostics.Append(requestDiags...)
 
@@ -439,10 +522,7 @@ func (r *PostgresBranchBackupResource) Delete(ctx context.Context, req resource.
 	}
 	res, err := r.client.Backups.DeletePostgresBranchBackup(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -453,7 +533,7 @@ func (r *PostgresBranchBackupResource) Delete(ctx context.Context, req resource.
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
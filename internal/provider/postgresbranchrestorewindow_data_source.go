@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PostgresBranchRestoreWindowDataSource{}
+var _ datasource.DataSourceWithConfigure = &PostgresBranchRestoreWindowDataSource{}
+
+func NewPostgresBranchRestoreWindowDataSource() datasource.DataSource {
+	return &PostgresBranchRestoreWindowDataSource{}
+}
+
+// PostgresBranchRestoreWindowDataSource is the data source implementation.
+type PostgresBranchRestoreWindowDataSource struct {
+	// Provider configured SDK client.
+	client *sdk.PlanetScale
+}
+
+// PostgresBranchRestoreWindowDataSourceModel describes the data model.
+type PostgresBranchRestoreWindowDataSourceModel struct {
+	Branch                types.String `tfsdk:"branch"`
+	Database              types.String `tfsdk:"database"`
+	EarliestBackupID      types.String `tfsdk:"earliest_backup_id"`
+	EarliestRestorePoint  types.String `tfsdk:"earliest_restore_point"`
+	LatestRestorePoint    types.String `tfsdk:"latest_restore_point"`
+	Organization          types.String `tfsdk:"organization"`
+	PointInTimeRestorable types.Bool   `tfsdk:"point_in_time_restorable"`
+}
+
+// Metadata returns the data source type name.
+func (r *PostgresBranchRestoreWindowDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres_branch_restore_window"
+}
+
+// Schema defines the schema for the data source.
+func (r *PostgresBranchRestoreWindowDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the range of times a Postgres branch can be restored to with `restore_point`. " +
+			"The window starts when the oldest retained successful backup of the branch completed and ends when the data source is read.",
+
+		Attributes: map[string]schema.Attribute{
+			"branch": schema.StringAttribute{
+				Required:    true,
+				Description: `The name of the branch to restore from`,
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: `The name of the database the branch belongs to`,
+			},
+			"earliest_backup_id": schema.StringAttribute{
+				Computed:    true,
+				Description: `The ID of the backup the earliest restore point is replayed from`,
+			},
+			"earliest_restore_point": schema.StringAttribute{
+				Computed:    true,
+				Description: `The earliest time the branch can be restored to, in RFC3339 format. Null when the branch has no successful backup.`,
+			},
+			"latest_restore_point": schema.StringAttribute{
+				Computed:    true,
+				Description: `The latest time the branch can be restored to, in RFC3339 format. Null when the branch has no successful backup.`,
+			},
+			"organization": schema.StringAttribute{
+				Required:    true,
+				Description: `The name of the organization the database belongs to`,
+			},
+			"point_in_time_restorable": schema.BoolAttribute{
+				Computed:    true,
+				Description: `Whether the branch has a successful backup to restore a point in time from`,
+			},
+		},
+	}
+}
+
+func (r *PostgresBranchRestoreWindowDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.PlanetScale)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *sdk.PlanetScale, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PostgresBranchRestoreWindowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *PostgresBranchRestoreWindowDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := getPostgresRestoreWindow(ctx, r.client, data.Organization.ValueString(), data.Database.ValueString(), data.Branch.ValueString(), time.Now().UTC())
+	switch {
+	case errors.Is(err, errNoRestorableBackup):
+		data.EarliestBackupID = types.StringNull()
+		data.EarliestRestorePoint = types.StringNull()
+		data.LatestRestorePoint = types.StringNull()
+		data.PointInTimeRestorable = types.BoolValue(false)
+	case err != nil:
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Config.Schema, err)...)
+		return
+	default:
+		data.EarliestBackupID = types.StringValue(window.earliestBackup)
+		data.EarliestRestorePoint = types.StringValue(window.earliest.Format(time.RFC3339))
+		data.LatestRestorePoint = types.StringValue(window.latest.Format(time.RFC3339))
+		data.PointInTimeRestorable = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
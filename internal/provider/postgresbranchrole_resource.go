@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	speakeasy_boolplanmodifier "github.com/planetscale/terraform-provider-planetscale/internal/planmodifiers/boolplanmodifier"
@@ -128,8 +129,12 @@ func (r *PostgresBranchRoleResource) Schema(ctx context.Context, req resource.Sc
 				Description: `When the role was created`,
 			},
 			"database": schema.StringAttribute{
-				Required:    true,
-				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"database_name": schema.StringAttribute{
 				Computed:    true,
@@ -183,8 +188,12 @@ func (r *PostgresBranchRoleResource) Schema(ctx context.Context, req resource.Sc
 				Description: `The name of the role`,
 			},
 			"organization": schema.StringAttribute{
-				Required:    true,
-				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"password": schema.StringAttribute{
 				Computed:    true,
@@ -292,10 +301,7 @@ func (r *PostgresBranchRoleResource) Create(ctx context.Context, req resource.Cr
 	}
 	res, err := r.client.Roles.CreateRole(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -303,7 +309,7 @@ func (r *PostgresBranchRoleResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -352,22 +358,23 @@ func (r *PostgresBranchRoleResource) Read(ctx context.Context, req resource.Read
 	}
 	res, err := r.client.Roles.GetRole(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundResource(ctx, resp)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundResource(ctx, resp)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -406,10 +413,7 @@ func (r *PostgresBranchRoleResource) Update(ctx context.Context, req resource.Up
 	}
 	res, err := r.client.Roles.UpdateRole(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -417,7 +421,7 @@ func (r *PostgresBranchRoleResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -466,10 +470,7 @@ func (r *PostgresBranchRoleResource) Delete(ctx context.Context, req resource.De
 	}
 	res, err := r.client.Roles.DeleteRole(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -480,7 +481,7 @@ func (r *PostgresBranchRoleResource) Delete(ctx context.Context, req resource.De
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
@@ -4,19 +4,27 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
-	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/shared"
+	"github.com/planetscale/terraform-provider-planetscale/internal/validators"
 	"net/http"
 	"os"
+	"regexp"
 )
 
 var _ provider.Provider = (*PlanetscaleProvider)(nil)
@@ -33,9 +41,23 @@ type PlanetscaleProvider struct {
 
 // PlanetscaleProviderModel describes the provider data model.
 type PlanetscaleProviderModel struct {
-	ServerURL      types.String `tfsdk:"server_url"`
-	ServiceToken   types.String `tfsdk:"service_token"`
-	ServiceTokenID types.String `tfsdk:"service_token_id"`
+	AccessToken        types.String               `tfsdk:"access_token"`
+	CaCertFile         types.String               `tfsdk:"ca_cert_file"`
+	CaCertPem          types.String               `tfsdk:"ca_cert_pem"`
+	ClientCertPem      types.String               `tfsdk:"client_cert_pem"`
+	ClientKeyPem       types.String               `tfsdk:"client_key_pem"`
+	Database           types.String               `tfsdk:"database"`
+	Headers            map[string]types.String    `tfsdk:"headers"`
+	InsecureSkipVerify types.Bool                 `tfsdk:"insecure_skip_verify"`
+	Organization       types.String               `tfsdk:"organization"`
+	ProxyURL           types.String               `tfsdk:"proxy_url"`
+	PscaleConfigDir    types.String               `tfsdk:"pscale_config_dir"`
+	RateLimit          *tfTypes.ProviderRateLimit `tfsdk:"rate_limit"`
+	RequestTimeout     types.String               `tfsdk:"request_timeout"`
+	Retries            *tfTypes.ProviderRetries   `tfsdk:"retries"`
+	ServerURL          types.String               `tfsdk:"server_url"`
+	ServiceToken       types.String               `tfsdk:"service_token"`
+	ServiceTokenID     types.String               `tfsdk:"service_token_id"`
 }
 
 func (p *PlanetscaleProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,6 +68,148 @@ func (p *PlanetscaleProvider) Metadata(ctx context.Context, req provider.Metadat
 func (p *PlanetscaleProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: `PlanetScale OAuth access token, sent as a Bearer token. Configurable via environment variable ` + "`" + `PLANETSCALE_ACCESS_TOKEN` + "`" + `. Without any configured credentials, the token stored by ` + "`" + `pscale auth login` + "`" + ` is used.`,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("service_token"), path.MatchRoot("service_token_id")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: `Path to a PEM encoded CA bundle trusted in addition to the system roots, for example when an egress proxy inspects TLS traffic.`,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: `PEM encoded CA certificates trusted in addition to the system roots.`,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: `PEM encoded client certificate presented for mutual TLS. Requires ` + "`" + `client_key_pem` + "`" + `.`,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: `PEM encoded private key of ` + "`" + `client_cert_pem` + "`" + `.`,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: `Default database of resources that do not set ` + "`" + `database` + "`" + `. Configurable via environment variable ` + "`" + `PLANETSCALE_DATABASE` + "`" + `.`,
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: `Extra HTTP headers sent with every API request. The ` + "`" + `Authorization` + "`" + ` header cannot be set.`,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Skip verification of the API server certificate. Only intended for local stand-ins of the API. Default: false`,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: `Default organization of resources that do not set ` + "`" + `organization` + "`" + `. Configurable via environment variable ` + "`" + `PLANETSCALE_ORG` + "`" + `. The organization selected with ` + "`" + `pscale org switch` + "`" + ` is not used.`,
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: `URL of the proxy used for API requests. Defaults to the ` + "`" + `HTTPS_PROXY` + "`" + ` and ` + "`" + `NO_PROXY` + "`" + ` environment variables.`,
+			},
+			"pscale_config_dir": schema.StringAttribute{
+				MarkdownDescription: `Directory holding the ` + "`" + `pscale` + "`" + ` CLI configuration and access token, read when no credentials are configured otherwise. Configurable via environment variable ` + "`" + `PLANETSCALE_CONFIG_DIR` + "`" + `. Defaults to ` + "`" + `~/.config/planetscale` + "`" + `.`,
+				Optional:            true,
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"adaptive": schema.BoolAttribute{
+						Optional:    true,
+						Description: `Whether rate limit headers returned by the API slow down requests, and pause them until the limit resets once it is exhausted. Default: true`,
+					},
+					"burst": schema.Int64Attribute{
+						Optional:    true,
+						Description: `Number of requests that may be sent back to back before ` + "`" + `requests_per_second` + "`" + ` applies. Default: 20`,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_in_flight": schema.Int64Attribute{
+						Optional:    true,
+						Description: `Maximum number of API requests awaiting a response at the same time, independently of Terraform parallelism. Set to 0 for no limit. Default: 10`,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"requests_per_second": schema.Float64Attribute{
+						Optional:    true,
+						Description: `Sustained rate of API requests sent by the provider. Set to 0 for no limit. Default: 10`,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+				},
+				Description: `Client-side throttling of API requests, shared by all resources and data sources of the provider. Requests are only throttled when this block is set; its attributes then default to the values below.`,
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: `Timeout of each API request, including its retries, as a duration such as "2m". Waiting for a resource to become ready is not limited by it. No timeout by default.`,
+				Validators: []validator.String{
+					validators.IsDuration(),
+				},
+			},
+			"retries": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"initial_interval": schema.StringAttribute{
+						Optional:    true,
+						Description: `Wait before the first retry, as a duration such as "500ms". Later waits grow exponentially with jitter. Default: "500ms"`,
+						Validators: []validator.String{
+							validators.IsDuration(),
+						},
+					},
+					"max_attempts": schema.Int64Attribute{
+						Optional:    true,
+						Description: `Maximum number of attempts for each API request, including the first one. Set to 1 to disable retries. Default: 5`,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_elapsed_time": schema.StringAttribute{
+						Optional:    true,
+						Description: `Maximum time spent retrying a single API request, as a duration such as "5m". Default: "5m"`,
+						Validators: []validator.String{
+							validators.IsDuration(),
+						},
+					},
+					"max_interval": schema.StringAttribute{
+						Optional:    true,
+						Description: `Maximum wait between two attempts, as a duration such as "30s". A ` + "`" + `Retry-After` + "`" + ` header sent by the API takes precedence. Default: "30s"`,
+						Validators: []validator.String{
+							validators.IsDuration(),
+						},
+					},
+					"retry_connection_errors": schema.BoolAttribute{
+						Optional:    true,
+						Description: `Whether to retry requests that fail to connect, time out or lose their connection. Requests that may have reached the API are only retried for idempotent methods. Default: true`,
+					},
+					"status_codes": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: `HTTP status codes treated as transient, either exact codes such as "429" or classes such as "5XX". Only 429 responses are retried for non-idempotent methods such as POST and PATCH. Default: ["429", "502", "503", "504"]`,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[1-5]([0-9]{2}|XX)$`), "must be an HTTP status code such as \"429\" or a class such as \"5XX\""),
+							),
+						},
+					},
+				},
+				Description: `Retry policy applied to every API request. Rate limited and temporarily unavailable responses are retried with exponential backoff by default.`,
+			},
 			"server_url": schema.StringAttribute{
 				Description: `Server URL (defaults to https://api.planetscale.com/v1)`,
 				Optional:    true,
@@ -84,50 +248,57 @@ func (p *PlanetscaleProvider) Configure(ctx context.Context, req provider.Config
 		serverUrl = "https://api.planetscale.com/v1"
 	}
 
-	security := shared.Security{}
+	defaults := sdk.Defaults{
+		Organization: data.Organization.ValueString(),
+		Database:     data.Database.ValueString(),
+	}
 
-	if !data.ServiceToken.IsUnknown() {
-		security.ServiceToken = data.ServiceToken.ValueString()
+	if defaults.Organization == "" {
+		defaults.Organization = os.Getenv("PLANETSCALE_ORG")
 	}
 
-	if serviceTokenEnvVar := os.Getenv("PLANETSCALE_SERVICE_TOKEN"); security.ServiceToken == "" && serviceTokenEnvVar != "" {
-		security.ServiceToken = serviceTokenEnvVar
+	if defaults.Database == "" {
+		defaults.Database = os.Getenv("PLANETSCALE_DATABASE")
 	}
 
-	if security.ServiceToken == "" {
-		resp.Diagnostics.AddError(
-			"Missing Provider Security Configuration",
-			"Either the environment variable PLANETSCALE_SERVICE_TOKEN or provider configuration service_token attribute must be configured.",
+	config, pscaleAccessToken, err := readPscaleConfig(pscaleConfigDir(&data))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read pscale Configuration",
+			"The pscale configuration could not be read and is ignored: "+err.Error(),
 		)
 	}
 
-	if !data.ServiceTokenID.IsUnknown() {
-		security.ServiceTokenID = data.ServiceTokenID.ValueString()
-	}
+	security, diags := resolveProviderCredentials(ctx, &data, config, pscaleAccessToken)
+	resp.Diagnostics.Append(diags...)
 
-	if serviceTokenIDEnvVar := os.Getenv("PLANETSCALE_SERVICE_TOKEN_ID"); security.ServiceTokenID == "" && serviceTokenIDEnvVar != "" {
-		security.ServiceTokenID = serviceTokenIDEnvVar
-	}
+	baseTransport, diags := newProviderBaseTransport(&data)
+	resp.Diagnostics.Append(diags...)
 
-	if security.ServiceTokenID == "" {
-		resp.Diagnostics.AddError(
-			"Missing Provider Security Configuration",
-			"Either the environment variable PLANETSCALE_SERVICE_TOKEN_ID or provider configuration service_token_id attribute must be configured.",
-		)
+	headers, diags := providerHeaders(&data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	providerHTTPTransportOpts := ProviderHTTPTransportOpts{
-		SetHeaders: make(map[string]string),
-		Transport:  http.DefaultTransport,
+		SetHeaders: headers,
+		Transport:  baseTransport,
+		Retries:    newProviderHTTPRetries(data.Retries),
+		RateLimit:  newProviderHTTPRateLimit(data.RateLimit),
 	}
 
-	httpClient := http.DefaultClient
-	httpClient.Transport = NewProviderHTTPTransport(providerHTTPTransportOpts)
+	httpClient := &http.Client{
+		Transport: NewProviderHTTPTransport(providerHTTPTransportOpts),
+		Timeout:   providerRequestTimeout(&data),
+	}
 
 	opts := []sdk.SDKOption{
 		sdk.WithServerURL(serverUrl),
 		sdk.WithSecurity(security),
 		sdk.WithClient(httpClient),
+		sdk.WithDefaults(defaults),
 	}
 
 	client := sdk.New(opts...)
@@ -143,17 +314,21 @@ func (p *PlanetscaleProvider) Functions(_ context.Context) []func() function.Fun
 }
 
 func (p *PlanetscaleProvider) Actions(_ context.Context) []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		NewOauthTokenRevokeAction,
+	}
 }
 
 func (p *PlanetscaleProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewBranchRestoreResource,
 		NewPostgresBackupPolicyResource,
 		NewPostgresBouncerResource,
 		NewPostgresBranchResource,
 		NewPostgresBranchBackupResource,
 		NewPostgresBranchRoleResource,
 		NewPostgresRedactedBranchRoleResource,
+		NewSchemaRecommendationDismissalResource,
 		NewVitessBackupPolicyResource,
 		NewVitessBranchResource,
 		NewVitessBranchBackupResource,
@@ -164,9 +339,16 @@ func (p *PlanetscaleProvider) Resources(ctx context.Context) []func() resource.R
 
 func (p *PlanetscaleProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCurrentIdentityDataSource,
 		NewDatabasePostgresDataSource,
 		NewDatabaseVitessDataSource,
 		NewDatabasesDataSource,
+		NewDeployQueueDataSource,
+		NewInvoiceDataSource,
+		NewInvoiceLineItemsDataSource,
+		NewInvoicesDataSource,
+		NewOauthApplicationDataSource,
+		NewOauthTokensDataSource,
 		NewOrganizationDataSource,
 		NewOrganizationsDataSource,
 		NewPostgresBackupPoliciesDataSource,
@@ -176,9 +358,11 @@ func (p *PlanetscaleProvider) DataSources(ctx context.Context) []func() datasour
 		NewPostgresBranchDataSource,
 		NewPostgresBranchBackupDataSource,
 		NewPostgresBranchBackupsDataSource,
+		NewPostgresBranchRestoreWindowDataSource,
 		NewPostgresBranchRoleDataSource,
 		NewPostgresBranchRolesDataSource,
 		NewPostgresRedactedBranchRoleDataSource,
+		NewSchemaRecommendationsDataSource,
 		NewVitessBackupPoliciesDataSource,
 		NewVitessBackupPolicyDataSource,
 		NewVitessBranchDataSource,
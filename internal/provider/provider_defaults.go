@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+)
+
+// applyProviderDefaults plans the provider level organization and database
+// for resources that leave them out of their configuration.
+//
+// This cannot be an attribute plan modifier: the framework builds the schema
+// from a resource instance that is never configured, so only the instance
+// ModifyPlan is called on has a client. A default that differs from the
+// value in state replaces the resource, like changing the attribute would.
+//
+// It runs first in every resource's ModifyPlan, through modifyPlan.
+func applyProviderDefaults(ctx context.Context, client *sdk.PlanetScale, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	defaults := client.Defaults()
+	for _, attribute := range []struct {
+		name  string
+		value string
+	}{
+		{name: "organization", value: defaults.Organization},
+		{name: "database", value: defaults.Database},
+	} {
+		attributePath := path.Root(attribute.name)
+
+		var configured types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attributePath, &configured)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !configured.IsNull() {
+			continue
+		}
+
+		if attribute.value == "" {
+			resp.Diagnostics.AddAttributeError(
+				attributePath,
+				"Missing Attribute Value",
+				fmt.Sprintf("The %s attribute is not configured and the provider has no default %s. Set one of them.", attribute.name, attribute.name),
+			)
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attributePath, types.StringValue(attribute.value))...)
+
+		if req.State.Raw.IsNull() {
+			continue
+		}
+		var current types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attributePath, &current)...)
+		if !current.IsNull() && !current.IsUnknown() && current.ValueString() != attribute.value {
+			resp.RequiresReplace = append(resp.RequiresReplace, attributePath)
+		}
+	}
+}
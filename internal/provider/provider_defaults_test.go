@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+// resourceObject builds a value of the schema's object type with the given
+// attributes set and every other attribute null.
+func resourceObject(t *testing.T, s schema.Schema, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	require.True(t, ok)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func TestApplyProviderDefaults(t *testing.T) {
+	t.Parallel()
+
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	testCases := map[string]struct {
+		defaults         sdk.Defaults
+		config           map[string]tftypes.Value
+		state            map[string]tftypes.Value
+		wantOrganization string
+		wantDatabase     string
+		wantReplace      bool
+		wantError        bool
+	}{
+		"create uses provider defaults": {
+			defaults:         sdk.Defaults{Organization: "acme", Database: "app"},
+			wantOrganization: "acme",
+			wantDatabase:     "app",
+		},
+		"configured values win": {
+			defaults:         sdk.Defaults{Organization: "acme", Database: "app"},
+			config:           map[string]tftypes.Value{"organization": str("other"), "database": str("db")},
+			wantOrganization: "other",
+			wantDatabase:     "db",
+		},
+		"unchanged default keeps the resource": {
+			defaults:         sdk.Defaults{Organization: "acme", Database: "app"},
+			state:            map[string]tftypes.Value{"organization": str("acme"), "database": str("app")},
+			wantOrganization: "acme",
+			wantDatabase:     "app",
+		},
+		"changed default replaces the resource": {
+			defaults:         sdk.Defaults{Organization: "other", Database: "app"},
+			state:            map[string]tftypes.Value{"organization": str("acme"), "database": str("app")},
+			wantOrganization: "other",
+			wantDatabase:     "app",
+			wantReplace:      true,
+		},
+		"no value and no default": {
+			defaults:  sdk.Defaults{Database: "app"},
+			wantError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewVitessBranchBackupResource()
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			var configureResp resource.ConfigureResponse
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+				ProviderData: sdk.New(sdk.WithDefaults(tc.defaults)),
+			}, &configureResp)
+			require.False(t, configureResp.Diagnostics.HasError())
+
+			s := schemaResp.Schema
+			config := map[string]tftypes.Value{"name": str("nightly")}
+			plan := map[string]tftypes.Value{"name": str("nightly"), "organization": unknown, "database": unknown}
+			for k, v := range tc.config {
+				config[k] = v
+				plan[k] = v
+			}
+
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if tc.state != nil {
+				stateValues := map[string]tftypes.Value{"name": str("nightly")}
+				for k, v := range tc.state {
+					stateValues[k] = v
+				}
+				state.Raw = resourceObject(t, s, stateValues)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: resourceObject(t, s, config)},
+				Plan:   tfsdk.Plan{Schema: s, Raw: resourceObject(t, s, plan)},
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+
+			require.Equal(t, tc.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tc.wantError {
+				return
+			}
+
+			var organization, database types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("organization"), &organization)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("database"), &database)...)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Equal(t, tc.wantOrganization, organization.ValueString())
+			require.Equal(t, tc.wantDatabase, database.ValueString())
+
+			if tc.wantReplace {
+				require.Equal(t, path.Paths{path.Root("organization")}, resp.RequiresReplace)
+			} else {
+				require.Empty(t, resp.RequiresReplace)
+			}
+		})
+	}
+}
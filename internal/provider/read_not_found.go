@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkerrors "github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/errors"
+)
+
+// isNotFoundResponse reports whether the API answered that the object does
+// not exist. Only a 404 counts: other statuses, such as a 403 after a
+// permissions change, must not drop the resource from state, whatever
+// error code their body carries.
+func isNotFoundResponse(res *http.Response) bool {
+	return res != nil && res.StatusCode == http.StatusNotFound
+}
+
+// isNotFoundError is isNotFoundResponse for errors returned by SDK methods,
+// which carry the response for status codes the API does not document.
+func isNotFoundError(err error) bool {
+	var apiErr *sdkerrors.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// removeNotFoundResource removes a resource whose object was deleted outside
+// of Terraform from state, so the next plan recreates it.
+func removeNotFoundResource(ctx context.Context, resp *resource.ReadResponse) {
+	tflog.Warn(ctx, "Resource not found, removing it from state so that it is recreated")
+
+	resp.State.RemoveResource(ctx)
+}
+
+// removeNotFoundProtectedResource is removeNotFoundResource for resources
+// with deletion protection. A protected object that disappeared is reported
+// as an error instead of being recreated, since an empty replacement is
+// rarely what the configuration intends.
+func removeNotFoundProtectedResource(ctx context.Context, resp *resource.ReadResponse, deletionProtected types.Bool) {
+	if !deletionProtected.ValueBool() {
+		removeNotFoundResource(ctx, resp)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Protected Resource Not Found",
+		"The resource has deletion_protected set but no longer exists in PlanetScale, so the provider will not plan to recreate it. "+
+			"If it was deleted on purpose, remove it from state with terraform state rm and apply again to create a new one.",
+	)
+}
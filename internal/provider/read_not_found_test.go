@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkerrors "github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNotFoundResponse(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, isNotFoundResponse(testAPIResponse(http.StatusNotFound, ``)))
+	require.False(t, isNotFoundResponse(testAPIResponse(http.StatusForbidden, `{"code":"not_found","message":"Not Found"}`)))
+	require.False(t, isNotFoundResponse(testAPIResponse(http.StatusForbidden, `{"code":"forbidden","message":"Forbidden"}`)))
+	require.False(t, isNotFoundResponse(testAPIResponse(http.StatusOK, `{"code":"not_found"}`)))
+	require.False(t, isNotFoundResponse(nil))
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	body := `{"code":"not_found","message":"Not Found"}`
+	require.False(t, isNotFoundError(sdkerrors.NewAPIError("unknown status code returned", http.StatusForbidden, body, nil)))
+	require.True(t, isNotFoundError(sdkerrors.NewAPIError("unknown status code returned", http.StatusNotFound, ``, nil)))
+	require.False(t, isNotFoundError(sdkerrors.NewAPIError("unknown status code returned", http.StatusConflict, ``, nil)))
+	require.False(t, isNotFoundError(errors.New("connection refused")))
+}
+
+func TestRemoveNotFoundProtectedResource(t *testing.T) {
+	t.Parallel()
+
+	newResponse := func() *resource.ReadResponse {
+		objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+		return &resource.ReadResponse{
+			State: tfsdk.State{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Computed: true},
+					},
+				},
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "abc"),
+				}),
+			},
+		}
+	}
+
+	resp := newResponse()
+	removeNotFoundProtectedResource(context.Background(), resp, types.BoolValue(false))
+	require.False(t, resp.Diagnostics.HasError())
+	require.True(t, resp.State.Raw.IsNull())
+
+	resp = newResponse()
+	removeNotFoundProtectedResource(context.Background(), resp, types.BoolValue(true))
+	require.True(t, resp.Diagnostics.HasError())
+	require.False(t, resp.State.Raw.IsNull())
+}
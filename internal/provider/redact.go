@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httputil"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkerrors "github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/errors"
+)
+
+// Placeholder written in place of redacted values.
+const redactedValue = "(sensitive)"
+
+// API response fields holding credentials that no schema exposes, so they
+// cannot be derived from a Sensitive flag.
+var additionalSensitiveJSONPaths = []string{
+	"plain_text_refresh_token",
+	"token",
+}
+
+// sensitiveJSONPaths returns the dotted paths of every attribute marked
+// Sensitive in the provider, resource, data source and ephemeral resource
+// schemas, plus additionalSensitiveJSONPaths. Attribute names match the API
+// JSON property names, so the paths apply to request and response bodies.
+var sensitiveJSONPaths = sync.OnceValue(func() [][]string {
+	ctx := context.Background()
+	p := &PlanetscaleProvider{}
+	paths := make(map[string]struct{})
+
+	var providerSchema provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &providerSchema)
+	collectSensitivePaths(nil, reflect.ValueOf(providerSchema.Schema.Attributes), paths)
+
+	for _, newResource := range p.Resources(ctx) {
+		var resp resource.SchemaResponse
+		newResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+		collectSensitivePaths(nil, reflect.ValueOf(resp.Schema.Attributes), paths)
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		var resp datasource.SchemaResponse
+		newDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+		collectSensitivePaths(nil, reflect.ValueOf(resp.Schema.Attributes), paths)
+	}
+
+	for _, newEphemeralResource := range p.EphemeralResources(ctx) {
+		var resp ephemeral.SchemaResponse
+		newEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+		collectSensitivePaths(nil, reflect.ValueOf(resp.Schema.Attributes), paths)
+	}
+
+	for _, path := range additionalSensitiveJSONPaths {
+		paths[path] = struct{}{}
+	}
+
+	result := make([][]string, 0, len(paths))
+	for path := range paths {
+		result = append(result, strings.Split(path, "."))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.Join(result[i], ".") < strings.Join(result[j], ".")
+	})
+
+	return result
+})
+
+// collectSensitivePaths walks a map of schema attributes. The schema packages
+// of resources, data sources and the provider share no exported interface
+// for nested attributes, so nesting is followed through the Attributes and
+// NestedObject fields by reflection.
+func collectSensitivePaths(prefix []string, attributes reflect.Value, paths map[string]struct{}) {
+	if attributes.Kind() != reflect.Map {
+		return
+	}
+
+	iter := attributes.MapRange()
+	for iter.Next() {
+		name := iter.Key().String()
+		path := append(append([]string{}, prefix...), name)
+
+		attribute := iter.Value()
+		if sensitive, ok := attribute.Interface().(interface{ IsSensitive() bool }); ok && sensitive.IsSensitive() {
+			paths[strings.Join(path, ".")] = struct{}{}
+			continue
+		}
+
+		for attribute.Kind() == reflect.Interface || attribute.Kind() == reflect.Pointer {
+			attribute = attribute.Elem()
+		}
+		if attribute.Kind() != reflect.Struct {
+			continue
+		}
+
+		if nested := attribute.FieldByName("Attributes"); nested.IsValid() {
+			collectSensitivePaths(path, nested, paths)
+		}
+		if object := attribute.FieldByName("NestedObject"); object.IsValid() && object.Kind() == reflect.Struct {
+			collectSensitivePaths(path, object.FieldByName("Attributes"), paths)
+		}
+	}
+}
+
+// redactJSON replaces the values of sensitive properties in a JSON document.
+// A property matches a sensitive path when the trailing keys of its location
+// equal the path; array indexes are not part of the location. Bodies that
+// are not JSON are returned unchanged.
+func redactJSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	var document any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return body
+	}
+
+	if !redactValue(nil, document) {
+		return body
+	}
+
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return body
+	}
+
+	return redacted
+}
+
+// redactValue redacts document in place and reports whether anything was
+// redacted.
+func redactValue(location []string, document any) bool {
+	redacted := false
+
+	switch value := document.(type) {
+	case map[string]any:
+		for key, child := range value {
+			childLocation := append(location, key)
+			if isSensitiveLocation(childLocation) {
+				if child != nil {
+					value[key] = redactedValue
+					redacted = true
+				}
+				continue
+			}
+			if redactValue(childLocation, child) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, child := range value {
+			if redactValue(location, child) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+func isSensitiveLocation(location []string) bool {
+	for _, path := range sensitiveJSONPaths() {
+		if len(path) > len(location) {
+			continue
+		}
+
+		matched := true
+		offset := len(location) - len(path)
+		for i, key := range path {
+			if location[offset+i] != key {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactHTTPDump redacts the body of an HTTP message dumped by httputil.
+// Chunked bodies are decoded first, as the chunk framing hides the JSON.
+func redactHTTPDump(dump []byte) []byte {
+	separator := []byte("\r\n\r\n")
+	i := bytes.Index(dump, separator)
+	if i < 0 {
+		return dump
+	}
+
+	head := dump[:i+len(separator)]
+	body := dump[i+len(separator):]
+
+	if bytes.Contains(bytes.ToLower(head), []byte("transfer-encoding: chunked")) {
+		if decoded, err := io.ReadAll(httputil.NewChunkedReader(bytes.NewReader(body))); err == nil {
+			body = decoded
+		}
+	}
+
+	return append(append([]byte{}, head...), redactJSON(body)...)
+}
+
+// redactedError returns the message of err for a diagnostic, with sensitive
+// values removed from any API response body it carries.
+func redactedError(err error) string {
+	var apiErr *sdkerrors.APIError
+	if !errors.As(err, &apiErr) || apiErr.Body == "" {
+		return err.Error()
+	}
+
+	redacted := *apiErr
+	redacted.Body = string(redactJSON([]byte(apiErr.Body)))
+
+	if apiErr == err {
+		return redacted.Error()
+	}
+
+	return strings.Replace(err.Error(), apiErr.Error(), redacted.Error(), 1)
+}
+
+// redactedString redacts a JSON document held in a string, such as a
+// request or response body logging field.
+func redactedString(body string) string {
+	return string(redactJSON([]byte(body)))
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitiveJSONPathsFromSchemas(t *testing.T) {
+	t.Parallel()
+
+	paths := map[string]bool{}
+	for _, path := range sensitiveJSONPaths() {
+		paths[strings.Join(path, ".")] = true
+	}
+
+	// planetscale_vitess_branch_password.plain_text and
+	// planetscale_postgres_branch_role.password are marked Sensitive.
+	require.True(t, paths["plain_text"])
+	require.True(t, paths["password"])
+	require.True(t, paths["service_token"])
+	require.False(t, paths["name"])
+}
+
+func TestRedactJSON(t *testing.T) {
+	t.Parallel()
+
+	body := `{"id":"pw-1","name":"app","plain_text":"pscale_pw_secret","data":[{"password":"secret","username":"app"}]}`
+
+	redacted := string(redactJSON([]byte(body)))
+	require.NotContains(t, redacted, "pscale_pw_secret")
+	require.NotContains(t, redacted, `"secret"`)
+	require.Contains(t, redacted, `"plain_text":"(sensitive)"`)
+	require.Contains(t, redacted, `"username":"app"`)
+
+	require.Equal(t, "not json", string(redactJSON([]byte("not json"))))
+	require.Equal(t, `{"id":"pw-1"}`, string(redactJSON([]byte(`{"id":"pw-1"}`))))
+}
+
+func TestRedactHTTPDumpChunked(t *testing.T) {
+	t.Parallel()
+
+	body := `{"plain_text":"pscale_pw_secret"}`
+	dump := "HTTP/1.1 201 Created\r\nTransfer-Encoding: chunked\r\n\r\n" +
+		fmt.Sprintf("%x\r\n%s\r\n0\r\n\r\n", len(body), body)
+
+	redacted := string(redactHTTPDump([]byte(dump)))
+	require.NotContains(t, redacted, "pscale_pw_secret")
+	require.True(t, strings.HasPrefix(redacted, "HTTP/1.1 201 Created\r\n"))
+}
+
+func TestRedactedError(t *testing.T) {
+	t.Parallel()
+
+	apiErr := sdkerrors.NewAPIError("unknown status code returned", http.StatusUnprocessableEntity, `{"password":"secret"}`, nil)
+
+	require.NotContains(t, redactedError(apiErr), "secret")
+	require.NotContains(t, redactedError(fmt.Errorf("creating role: %w", apiErr)), "secret")
+	require.Contains(t, redactedError(fmt.Errorf("creating role: %w", apiErr)), "creating role: unknown status code returned: Status 422")
+}
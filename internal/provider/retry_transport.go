@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/retry"
+)
+
+// Retry defaults, used for any attribute of the provider retries block that
+// is not configured.
+const (
+	defaultRetryMaxAttempts     = 5
+	defaultRetryInitialInterval = 500 * time.Millisecond
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMaxElapsedTime  = 5 * time.Minute
+	defaultRetryExponent        = 1.5
+)
+
+var defaultRetryStatusCodes = []string{"429", "502", "503", "504"}
+
+// Connection errors and server errors are only retried for these methods
+// (RFC 7231 4.2), since the API may have acted on the request already. A
+// connection that could not be established and a 429 response are retried
+// for any method, as the request was not processed.
+var idempotentHTTPMethods = []string{
+	http.MethodDelete,
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+}
+
+// Retry policy for the provider HTTP transport.
+type ProviderHTTPRetries struct {
+	// Backoff strategy and connection error handling.
+	Config *retry.Config
+
+	// Maximum number of attempts, including the first one. Zero only bounds
+	// attempts by the backoff maximum elapsed time.
+	MaxAttempts int
+
+	// HTTP status codes that are retried, such as "429" or "5XX".
+	StatusCodes []string
+}
+
+// newProviderHTTPRetries builds the transport retry policy from the provider
+// retries block, filling in defaults for unset attributes. Durations are
+// checked by the schema validators, so parse errors fall back to defaults.
+func newProviderHTTPRetries(config *tfTypes.ProviderRetries) *ProviderHTTPRetries {
+	if config == nil {
+		config = &tfTypes.ProviderRetries{}
+	}
+
+	retries := &ProviderHTTPRetries{
+		Config: &retry.Config{
+			Strategy: "backoff",
+			Backoff: &retry.BackoffStrategy{
+				InitialInterval: int(durationOrDefault(config.InitialInterval.ValueString(), defaultRetryInitialInterval).Milliseconds()),
+				MaxInterval:     int(durationOrDefault(config.MaxInterval.ValueString(), defaultRetryMaxInterval).Milliseconds()),
+				Exponent:        defaultRetryExponent,
+				MaxElapsedTime:  int(durationOrDefault(config.MaxElapsedTime.ValueString(), defaultRetryMaxElapsedTime).Milliseconds()),
+			},
+			RetryConnectionErrors: true,
+		},
+		MaxAttempts: defaultRetryMaxAttempts,
+		StatusCodes: defaultRetryStatusCodes,
+	}
+
+	if !config.MaxAttempts.IsNull() && !config.MaxAttempts.IsUnknown() {
+		retries.MaxAttempts = int(config.MaxAttempts.ValueInt64())
+	}
+
+	if !config.RetryConnectionErrors.IsNull() && !config.RetryConnectionErrors.IsUnknown() {
+		retries.Config.RetryConnectionErrors = config.RetryConnectionErrors.ValueBool()
+	}
+
+	if config.StatusCodes != nil {
+		retries.StatusCodes = make([]string, 0, len(config.StatusCodes))
+		for _, code := range config.StatusCodes {
+			retries.StatusCodes = append(retries.StatusCodes, code.ValueString())
+		}
+	}
+
+	return retries
+}
+
+func durationOrDefault(value string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return d
+	}
+	return fallback
+}
+
+// Invokes the wrapped transport, retrying transient failures according to the
+// configured retry policy. Every retry is logged with the transaction ID
+// field carried by ctx.
+func (t *providerHttpTransport) roundTripWithRetries(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if t.retries == nil || t.retries.Config == nil || t.retries.Config.Strategy != "backoff" || t.retries.Config.Backoff == nil {
+		return t.send(ctx, req)
+	}
+
+	// The request body is replayed on every attempt.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	var (
+		start          = time.Now()
+		maxElapsedTime = time.Duration(t.retries.Config.Backoff.MaxElapsedTime) * time.Millisecond
+		attemptReq     = req
+	)
+
+	for attempt := 1; ; attempt++ {
+		res, err := t.send(ctx, attemptReq)
+
+		reason := t.retryReason(req, res, err)
+		if reason == nil {
+			return res, err
+		}
+
+		fields := map[string]interface{}{
+			"attempt":              attempt,
+			"max_attempts":         t.retries.MaxAttempts,
+			"reason":               reason.Error(),
+			FieldHttpRequestMethod: req.Method,
+			FieldHttpRequestUri:    req.URL.RequestURI(),
+		}
+
+		if t.retries.MaxAttempts > 0 && attempt >= t.retries.MaxAttempts {
+			tflog.Warn(ctx, "Giving up on HTTP request after reaching maximum attempts", fields)
+			return res, err
+		}
+
+		wait := t.retries.Config.Backoff.NextInterval(attempt - 1)
+
+		var temporary *retry.TemporaryError
+		if errors.As(reason, &temporary) && temporary.RetryAfter() > 0 {
+			wait = temporary.RetryAfter()
+		}
+
+		if maxElapsedTime > 0 && time.Since(start)+wait > maxElapsedTime {
+			tflog.Warn(ctx, "Giving up on HTTP request after reaching maximum elapsed time", fields)
+			return res, err
+		}
+
+		next, cloneErr := cloneRequestForRetry(req)
+		if cloneErr != nil {
+			tflog.Error(ctx, "Failed to rewind request body for retry", map[string]interface{}{
+				"error": cloneErr,
+			})
+			return res, err
+		}
+
+		fields["wait"] = wait.String()
+		tflog.Warn(ctx, "Retrying HTTP request after transient failure", fields)
+
+		if res != nil && res.Body != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		attemptReq = next
+	}
+}
+
+// Returns a temporary error describing why the attempt should be retried, or
+// nil when the result should be returned to the caller as is.
+func (t *providerHttpTransport) retryReason(req *http.Request, res *http.Response, err error) error {
+	if err != nil {
+		if !t.retries.Config.RetryConnectionErrors || req.Context().Err() != nil {
+			return nil
+		}
+		if isRetryableConnectionError(req.Method, err) {
+			return retry.Temporary(err.Error())
+		}
+		return nil
+	}
+
+	if res == nil || !matchesStatusCode(t.retries.StatusCodes, res.StatusCode) {
+		return nil
+	}
+	if res.StatusCode == http.StatusTooManyRequests || slices.Contains(idempotentHTTPMethods, req.Method) {
+		return retry.TemporaryFromResponse(fmt.Sprintf("received HTTP status %d", res.StatusCode), res)
+	}
+
+	return nil
+}
+
+func isRetryableConnectionError(method string, err error) bool {
+	// Nothing was sent when the connection could not be established, so the
+	// request is safe to replay whatever its method.
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if !slices.Contains(idempotentHTTPMethods, method) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// Reports whether statusCode matches one of codes, where a code is either an
+// exact status such as "429" or a class such as "5XX".
+func matchesStatusCode(codes []string, statusCode int) bool {
+	for _, code := range codes {
+		if strings.HasSuffix(strings.ToUpper(code), "XX") {
+			class, err := strconv.Atoi(code[:1])
+			if err == nil && statusCode/100 == class {
+				return true
+			}
+			continue
+		}
+
+		if parsed, err := strconv.Atoi(code); err == nil && parsed == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cloneRequestForRetry(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
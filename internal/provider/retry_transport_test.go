@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/retry"
+	"github.com/stretchr/testify/require"
+)
+
+func testRetryTransport(maxAttempts int, statusCodes ...string) *providerHttpTransport {
+	return NewProviderHTTPTransport(ProviderHTTPTransportOpts{
+		Transport: http.DefaultTransport,
+		Retries: &ProviderHTTPRetries{
+			Config: &retry.Config{
+				Strategy: "backoff",
+				Backoff: &retry.BackoffStrategy{
+					InitialInterval: 1,
+					MaxInterval:     5,
+					Exponent:        1.5,
+					MaxElapsedTime:  5000,
+				},
+				RetryConnectionErrors: true,
+			},
+			MaxAttempts: maxAttempts,
+			StatusCodes: statusCodes,
+		},
+	})
+}
+
+func TestProviderHTTPTransportRetriesTransientStatus(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls  atomic.Int32
+		mu     sync.Mutex
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, server.URL, strings.NewReader(`{"name":"main"}`))
+	require.NoError(t, err)
+
+	res, err := testRetryTransport(5, "5XX").RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	require.EqualValues(t, 3, calls.Load())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{`{"name":"main"}`, `{"name":"main"}`, `{"name":"main"}`}, bodies)
+}
+
+func TestProviderHTTPTransportStopsAtMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	res, err := testRetryTransport(2, "429").RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestProviderHTTPTransportDoesNotRetryOtherStatus(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	res, err := testRetryTransport(5, "429", "503").RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestProviderHTTPTransportRetriesNonIdempotentOnlyWhenRateLimited(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status    int
+		wantCalls int32
+	}{
+		"server error": {status: http.StatusServiceUnavailable, wantCalls: 1},
+		"rate limited": {status: http.StatusTooManyRequests, wantCalls: 3},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(`{"name":"main"}`))
+			require.NoError(t, err)
+
+			res, err := testRetryTransport(3, "429", "5XX").RoundTrip(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			require.Equal(t, tc.status, res.StatusCode)
+			require.Equal(t, tc.wantCalls, calls.Load())
+		})
+	}
+}
+
+func TestNewProviderHTTPRetriesDefaults(t *testing.T) {
+	t.Parallel()
+
+	retries := newProviderHTTPRetries(nil)
+	require.Equal(t, defaultRetryMaxAttempts, retries.MaxAttempts)
+	require.Equal(t, defaultRetryStatusCodes, retries.StatusCodes)
+	require.True(t, retries.Config.RetryConnectionErrors)
+	require.Equal(t, 500, retries.Config.Backoff.InitialInterval)
+
+	retries = newProviderHTTPRetries(&tfTypes.ProviderRetries{
+		InitialInterval:       types.StringValue("2s"),
+		MaxAttempts:           types.Int64Value(1),
+		RetryConnectionErrors: types.BoolValue(false),
+		StatusCodes:           []types.String{},
+	})
+	require.Equal(t, 1, retries.MaxAttempts)
+	require.Empty(t, retries.StatusCodes)
+	require.False(t, retries.Config.RetryConnectionErrors)
+	require.Equal(t, 2000, retries.Config.Backoff.InitialInterval)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/shared"
+	"github.com/stretchr/testify/require"
+)
+
+// testAPIHandler serves a request to the fake API. It returns an error when
+// the request is not the one the test expects.
+type testAPIHandler func(w http.ResponseWriter, r *http.Request) error
+
+// newTestClient returns an SDK client for a fake API served by handler.
+//
+// Handlers run on the server goroutines, where require cannot stop the test,
+// so the errors they return are collected and asserted by the test goroutine
+// once the server is closed.
+func newTestClient(t *testing.T, handler testAPIHandler, opts ...sdk.SDKOption) *sdk.PlanetScale {
+	t.Helper()
+
+	var (
+		mu   sync.Mutex
+		errs []string
+	)
+
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		require.Empty(t, errs, "unexpected requests to the fake API")
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := handler(w, r); err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Sprintf("%s %s: %s", r.Method, r.URL.RequestURI(), err))
+			mu.Unlock()
+			http.Error(w, err.Error(), http.StatusTeapot)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return sdk.New(append([]sdk.SDKOption{
+		sdk.WithServerURL(server.URL),
+		sdk.WithClient(server.Client()),
+		sdk.WithSecurity(shared.Security{ServiceToken: "token", ServiceTokenID: "token-id"}),
+	}, opts...)...)
+}
+
+// expectRequest returns an error unless r has the given method and path.
+func expectRequest(r *http.Request, method, path string) error {
+	if r.Method != method || r.URL.Path != path {
+		return fmt.Errorf("expected %s %s", method, path)
+	}
+	return nil
+}
+
+// writeJSON writes body as a successful JSON response.
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
@@ -0,0 +1,7 @@
+variable "organization" {
+  type = string
+}
+
+data "planetscale_current_identity" "test" {
+  organization = var.organization
+}
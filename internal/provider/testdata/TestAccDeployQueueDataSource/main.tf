@@ -0,0 +1,12 @@
+variable "organization" {
+  type = string
+}
+
+variable "database_name" {
+  type = string
+}
+
+data "planetscale_deploy_queue" "test" {
+  organization = var.organization
+  database     = var.database_name
+}
@@ -0,0 +1,7 @@
+variable "organization" {
+  type = string
+}
+
+data "planetscale_invoices" "test" {
+  organization = var.organization
+}
@@ -0,0 +1,13 @@
+variable "organization" {
+  type = string
+}
+
+variable "database_name" {
+  type = string
+}
+
+data "planetscale_schema_recommendations" "test" {
+  organization = var.organization
+  database     = var.database_name
+  state        = "open"
+}
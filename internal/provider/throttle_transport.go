@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
+)
+
+// Throttle defaults, used for any attribute of the provider rate_limit block
+// that is not configured when the block itself is set.
+const (
+	defaultRateLimitRequestsPerSecond = 10
+	defaultRateLimitBurst             = 20
+	defaultRateLimitMaxInFlight       = 10
+)
+
+// The adaptive rate never drops below this, so a misleading header cannot
+// stall an apply indefinitely.
+const minAdaptiveRequestsPerSecond = 0.1
+
+// Rate limit response header names, in order of preference. The first set is
+// the de facto X-RateLimit convention, the second the IETF RateLimit draft.
+var rateLimitHeaderSets = [][3]string{
+	{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"},
+	{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"},
+}
+
+// Client-side throttling for the provider HTTP transport.
+type ProviderHTTPRateLimit struct {
+	// Sustained request rate. Zero disables the token bucket.
+	RequestsPerSecond float64
+
+	// Number of requests that may be sent at once before the sustained rate
+	// applies.
+	Burst int
+
+	// Maximum number of requests waiting on a response at the same time.
+	// Zero disables the limit.
+	MaxInFlight int
+
+	// Whether rate limit response headers lower the request rate, or pause
+	// sending until the reported window resets.
+	Adaptive bool
+}
+
+// newProviderHTTPRateLimit builds the transport throttle configuration from
+// the provider rate_limit block, filling in defaults for unset attributes.
+// Throttling is opt-in: without the block, requests are not throttled.
+func newProviderHTTPRateLimit(config *tfTypes.ProviderRateLimit) *ProviderHTTPRateLimit {
+	if config == nil {
+		return nil
+	}
+
+	rateLimit := &ProviderHTTPRateLimit{
+		RequestsPerSecond: defaultRateLimitRequestsPerSecond,
+		Burst:             defaultRateLimitBurst,
+		MaxInFlight:       defaultRateLimitMaxInFlight,
+		Adaptive:          true,
+	}
+
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		rateLimit.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+
+	if !config.Burst.IsNull() && !config.Burst.IsUnknown() {
+		rateLimit.Burst = int(config.Burst.ValueInt64())
+	}
+
+	if !config.MaxInFlight.IsNull() && !config.MaxInFlight.IsUnknown() {
+		rateLimit.MaxInFlight = int(config.MaxInFlight.ValueInt64())
+	}
+
+	if !config.Adaptive.IsNull() && !config.Adaptive.IsUnknown() {
+		rateLimit.Adaptive = config.Adaptive.ValueBool()
+	}
+
+	return rateLimit
+}
+
+// requestThrottle combines a token bucket, shared by all requests of the
+// provider instance, with a semaphore bounding in-flight requests.
+type requestThrottle struct {
+	inFlight chan struct{}
+	adaptive bool
+
+	mu          sync.Mutex
+	baseRate    float64
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newRequestThrottle(opts *ProviderHTTPRateLimit) *requestThrottle {
+	if opts == nil || (opts.RequestsPerSecond <= 0 && opts.MaxInFlight <= 0) {
+		return nil
+	}
+
+	burst := float64(opts.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	throttle := &requestThrottle{
+		adaptive: opts.Adaptive,
+		baseRate: opts.RequestsPerSecond,
+		rate:     opts.RequestsPerSecond,
+		burst:    burst,
+		tokens:   burst,
+		last:     time.Now(),
+	}
+
+	if opts.MaxInFlight > 0 {
+		throttle.inFlight = make(chan struct{}, opts.MaxInFlight)
+	}
+
+	return throttle
+}
+
+// Blocks until the request may be sent, returning a function that must be
+// called once the response headers have been received.
+func (r *requestThrottle) acquire(ctx context.Context) (func(), error) {
+	if r.inFlight != nil {
+		select {
+		case r.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if r.inFlight != nil {
+			<-r.inFlight
+		}
+	}
+
+	for {
+		wait := r.reserve()
+		if wait <= 0 {
+			return release, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for client-side rate limit", map[string]interface{}{
+			"wait": wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			release()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Takes a token when one is available, otherwise returns how long to wait
+// before trying again.
+func (r *requestThrottle) reserve() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(r.pausedUntil) {
+		return r.pausedUntil.Sub(now)
+	}
+
+	if r.rate <= 0 {
+		return 0
+	}
+
+	r.tokens = math.Min(r.burst, r.tokens+now.Sub(r.last).Seconds()*r.rate)
+	r.last = now
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+}
+
+// Adjusts the token bucket to the rate limit reported by the API. When the
+// remaining budget is exhausted, or the API answers 429 with Retry-After,
+// every request of the provider instance waits for the window to reset.
+func (r *requestThrottle) observe(ctx context.Context, res *http.Response) {
+	if !r.adaptive || res == nil {
+		return
+	}
+
+	now := time.Now()
+	remaining, reset, ok := rateLimitFromHeaders(res.Header, now)
+
+	var pause time.Duration
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		pause = retryAfterFromHeaders(res.Header, now)
+		if pause <= 0 && ok {
+			pause = reset
+		}
+	case ok && remaining <= 0:
+		pause = reset
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if pause > 0 {
+		if until := now.Add(pause); until.After(r.pausedUntil) {
+			r.pausedUntil = until
+			tflog.Info(ctx, "API rate limit reached, pausing requests", map[string]interface{}{
+				"wait": pause.String(),
+			})
+		}
+		return
+	}
+
+	if !ok || r.baseRate <= 0 {
+		return
+	}
+
+	// Spread the remaining budget over the rest of the window, never going
+	// faster than the configured rate.
+	rate := r.baseRate
+	if reset > 0 {
+		rate = math.Max(minAdaptiveRequestsPerSecond, math.Min(r.baseRate, float64(remaining)/reset.Seconds()))
+	}
+
+	if rate != r.rate {
+		tflog.Debug(ctx, "Adjusted client-side rate limit from API response headers", map[string]interface{}{
+			"requests_per_second": rate,
+			"remaining":           remaining,
+			"reset":               reset.String(),
+		})
+		r.rate = rate
+	}
+}
+
+// Returns the remaining request budget and the time until the window resets.
+// Reset values larger than a day are treated as Unix timestamps.
+func rateLimitFromHeaders(header http.Header, now time.Time) (int, time.Duration, bool) {
+	for _, names := range rateLimitHeaderSets {
+		remainingVal := header.Get(names[1])
+		if remainingVal == "" {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(remainingVal)
+		if err != nil {
+			continue
+		}
+
+		var reset time.Duration
+		if resetVal, err := strconv.ParseInt(header.Get(names[2]), 10, 64); err == nil && resetVal > 0 {
+			if resetVal > int64((24 * time.Hour).Seconds()) {
+				reset = time.Unix(resetVal, 0).Sub(now)
+			} else {
+				reset = time.Duration(resetVal) * time.Second
+			}
+		}
+		if reset < 0 {
+			reset = 0
+		}
+
+		return remaining, reset, true
+	}
+
+	return 0, 0, false
+}
+
+func retryAfterFromHeaders(header http.Header, now time.Time) time.Duration {
+	retryAfter := header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// Sends a single attempt through the wrapped transport, subject to the
+// client-side throttle.
+func (t *providerHttpTransport) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if t.throttle == nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	release, err := t.throttle.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := t.transport.RoundTrip(req)
+	if err == nil {
+		t.throttle.observe(ctx, res)
+	}
+
+	return res, err
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderHTTPTransportLimitsInFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := NewProviderHTTPTransport(ProviderHTTPTransportOpts{
+		Transport: http.DefaultTransport,
+		RateLimit: &ProviderHTTPRateLimit{MaxInFlight: 2},
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 6)
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			res, err := transport.RoundTrip(req)
+			if err != nil {
+				errs <- err
+				return
+			}
+			res.Body.Close()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestRequestThrottleTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	throttle := newRequestThrottle(&ProviderHTTPRateLimit{RequestsPerSecond: 1, Burst: 2})
+
+	require.Zero(t, throttle.reserve())
+	require.Zero(t, throttle.reserve())
+
+	wait := throttle.reserve()
+	require.Greater(t, wait, time.Duration(0))
+	require.LessOrEqual(t, wait, time.Second)
+}
+
+func TestRequestThrottleAdaptsToRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+
+	throttle := newRequestThrottle(&ProviderHTTPRateLimit{RequestsPerSecond: 10, Burst: 1, Adaptive: true})
+
+	res := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	res.Header.Set("X-RateLimit-Remaining", "30")
+	res.Header.Set("X-RateLimit-Reset", "60")
+	throttle.observe(context.Background(), res)
+	require.InDelta(t, 0.5, throttle.rate, 0.001)
+
+	res = &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	res.Header.Set("Retry-After", "30")
+	throttle.observe(context.Background(), res)
+
+	wait := throttle.reserve()
+	require.Greater(t, wait, 25*time.Second)
+	require.LessOrEqual(t, wait, 30*time.Second)
+}
+
+func TestRateLimitFromHeadersUnixReset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	header := http.Header{}
+	header.Set("RateLimit-Remaining", "0")
+	header.Set("RateLimit-Reset", "1700000045")
+
+	remaining, reset, ok := rateLimitFromHeaders(header, now)
+	require.True(t, ok)
+	require.Zero(t, remaining)
+	require.Equal(t, 45*time.Second, reset)
+}
+
+func TestNewProviderHTTPRateLimitIsOptIn(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, newProviderHTTPRateLimit(nil))
+	require.Nil(t, newRequestThrottle(newProviderHTTPRateLimit(nil)))
+
+	rateLimit := newProviderHTTPRateLimit(&tfTypes.ProviderRateLimit{MaxInFlight: types.Int64Value(4)})
+	require.Equal(t, &ProviderHTTPRateLimit{
+		RequestsPerSecond: defaultRateLimitRequestsPerSecond,
+		Burst:             defaultRateLimitBurst,
+		MaxInFlight:       4,
+		Adaptive:          true,
+	}, rateLimit)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CurrentIdentityAccess struct {
+	Access       types.String `tfsdk:"access"`
+	Description  types.String `tfsdk:"description"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CurrentIdentityUser struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	Email       types.String `tfsdk:"email"`
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+}
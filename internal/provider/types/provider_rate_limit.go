@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProviderRateLimit struct {
+	Adaptive          types.Bool    `tfsdk:"adaptive"`
+	Burst             types.Int64   `tfsdk:"burst"`
+	MaxInFlight       types.Int64   `tfsdk:"max_in_flight"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProviderRetries struct {
+	InitialInterval       types.String   `tfsdk:"initial_interval"`
+	MaxAttempts           types.Int64    `tfsdk:"max_attempts"`
+	MaxElapsedTime        types.String   `tfsdk:"max_elapsed_time"`
+	MaxInterval           types.String   `tfsdk:"max_interval"`
+	RetryConnectionErrors types.Bool     `tfsdk:"retry_connection_errors"`
+	StatusCodes           []types.String `tfsdk:"status_codes"`
+}
@@ -42,7 +42,7 @@ func debugResponse(response *http.Response) string {
 			return err.Error()
 		}
 	}
-	return fmt.Sprintf("**Request**:\n%s\n**Response**:\n%s", string(dumpReq), string(dumpRes))
+	return fmt.Sprintf("**Request**:\n%s\n**Response**:\n%s", string(redactHTTPDump(dumpReq)), string(redactHTTPDump(dumpRes)))
 }
 
 func merge(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, target interface{}) {
@@ -101,6 +101,12 @@ type ProviderHTTPTransportOpts struct {
 
 	// Underlying HTTP transport.
 	Transport http.RoundTripper
+
+	// Retry policy for transient failures. Nil disables retries.
+	Retries *ProviderHTTPRetries
+
+	// Client-side rate and concurrency limits. Nil disables throttling.
+	RateLimit *ProviderHTTPRateLimit
 }
 
 // Note: this is taken as a more minimal/specific version of https://github.com/hashicorp/terraform-plugin-sdk/blob/main/helper/logging/logging_http_transport.go
@@ -108,6 +114,8 @@ func NewProviderHTTPTransport(opts ProviderHTTPTransportOpts) *providerHttpTrans
 	return &providerHttpTransport{
 		setHeaders: opts.SetHeaders,
 		transport:  opts.Transport,
+		retries:    opts.Retries,
+		throttle:   newRequestThrottle(opts.RateLimit),
 	}
 }
 
@@ -129,6 +137,8 @@ const (
 type providerHttpTransport struct {
 	setHeaders map[string]string
 	transport  http.RoundTripper
+	retries    *ProviderHTTPRetries
+	throttle   *requestThrottle
 }
 
 func (t *providerHttpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -148,8 +158,8 @@ func (t *providerHttpTransport) RoundTrip(req *http.Request) (*http.Response, er
 		tflog.Debug(ctx, "Sending HTTP Request", []map[string]interface{}{fields}...)
 	}
 
-	// Invoke the wrapped RoundTrip now
-	res, err := t.transport.RoundTrip(req)
+	// Invoke the wrapped RoundTrip now, retrying transient failures
+	res, err := t.roundTripWithRetries(ctx, req)
 	if err != nil {
 		return res, err
 	}
@@ -211,7 +221,7 @@ func decomposeRequestForLogging(req *http.Request) (map[string]interface{}, erro
 	}
 
 	// Read the rest of the body content
-	fields[FieldHttpRequestBody] = bodyFromRestOfRequestReader(reqReader)
+	fields[FieldHttpRequestBody] = redactedString(bodyFromRestOfRequestReader(reqReader))
 	return fields, nil
 }
 
@@ -287,7 +297,7 @@ func decomposeResponseForLogging(res *http.Response) (map[string]interface{}, er
 	// http.Client
 	res.Body = io.NopCloser(bytes.NewBuffer(resBody))
 
-	fields[FieldHttpResponseBody] = string(resBody)
+	fields[FieldHttpResponseBody] = redactedString(string(resBody))
 
 	return fields, nil
 }
@@ -11,10 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
+	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -39,6 +42,8 @@ type VitessBackupPolicyResourceModel struct {
 	FrequencyValue types.Int64  `tfsdk:"frequency_value"`
 	ID             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
+	NextRunAt      types.String `tfsdk:"next_run_at"`
+	NextRuns       types.List   `tfsdk:"next_runs"`
 	Organization   types.String `tfsdk:"organization"`
 	RetentionUnit  types.String `tfsdk:"retention_unit"`
 	RetentionValue types.Int64  `tfsdk:"retention_value"`
@@ -57,8 +62,12 @@ func (r *VitessBackupPolicyResource) Schema(ctx context.Context, req resource.Sc
 		MarkdownDescription: "VitessBackupPolicy Resource",
 		Attributes: map[string]schema.Attribute{
 			"database": schema.StringAttribute{
-				Required:    true,
-				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Database name slug from ` + "`" + `list_databases` + "`" + `. Example: ` + "`" + `app-db` + "`" + `. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"display_name": schema.StringAttribute{
 				Computed:    true,
@@ -91,9 +100,22 @@ func (r *VitessBackupPolicyResource) Schema(ctx context.Context, req resource.Sc
 				Optional:    true,
 				Description: `The name of the backup policy`,
 			},
+			"next_run_at": schema.StringAttribute{
+				Computed:    true,
+				Description: `The time of the next scheduled backup, in RFC3339 format. Estimated by the provider from the schedule, in UTC, and not reported by PlanetScale. A ` + "`" + `frequency_value` + "`" + ` above 1 is counted from the Unix epoch, so actual runs may fall on other hours, days, weeks or months`,
+			},
+			"next_runs": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: `The times of the next five scheduled backups, in RFC3339 format. Estimated by the provider from the schedule, in UTC, and not reported by PlanetScale. A ` + "`" + `frequency_value` + "`" + ` above 1 is counted from the Unix epoch, so actual runs may fall on other hours, days, weeks or months`,
+			},
 			"organization": schema.StringAttribute{
-				Required:    true,
-				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `.`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `Organization name slug from ` + "`" + `list_organizations` + "`" + `. Example: ` + "`" + `acme` + "`" + `. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"retention_unit": schema.StringAttribute{
 				Computed:    true,
@@ -117,7 +139,7 @@ func (r *VitessBackupPolicyResource) Schema(ctx context.Context, req resource.Sc
 			"schedule_day": schema.Int64Attribute{
 				Computed:    true,
 				Optional:    true,
-				Description: `Day of the week that the backup is scheduled. 0 is Sunday, 6 is Saturday`,
+				Description: `Day of the week that the backup is scheduled. 0 is Sunday, 6 is Saturday. Required for weekly and monthly policies`,
 			},
 			"schedule_time": schema.StringAttribute{
 				Computed:    true,
@@ -127,7 +149,7 @@ func (r *VitessBackupPolicyResource) Schema(ctx context.Context, req resource.Sc
 			"schedule_week": schema.Int64Attribute{
 				Computed:    true,
 				Optional:    true,
-				Description: `Week of the month that the backup is scheduled. 0 is the first week, 3 is the fourth week`,
+				Description: `Week of the month that the backup is scheduled. 0 is the first week, 3 is the fourth week. Required for monthly policies`,
 			},
 			"target": schema.StringAttribute{
 				Computed:    true,
@@ -190,10 +212,7 @@ func (r *VitessBackupPolicyResource) Create(ctx context.Context, req resource.Cr
 	}
 	res, err := r.client.BackupPolicies.CreateVitessBackupPolicy(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -201,7 +220,7 @@ func (r *VitessBackupPolicyResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	if res.StatusCode != 201 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -219,6 +238,9 @@ func (r *VitessBackupPolicyResource) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !nextRunsPlanned(plan) {
+		data.refreshNextRuns(time.Now())
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -250,22 +272,23 @@ func (r *VitessBackupPolicyResource) Read(ctx context.Context, req resource.Read
 	}
 	res, err := r.client.BackupPolicies.GetVitessBackupPolicy(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundResource(ctx, resp)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundResource(ctx, resp)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -277,6 +300,7 @@ func (r *VitessBackupPolicyResource) Read(ctx context.Context, req resource.Read
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.refreshNextRuns(time.Now())
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -304,10 +328,7 @@ func (r *VitessBackupPolicyResource) Update(ctx context.Context, req resource.Up
 	}
 	res, err := r.client.BackupPolicies.UpdateVitessBackupPolicy(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -315,7 +336,7 @@ func (r *VitessBackupPolicyResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -333,6 +354,9 @@ func (r *VitessBackupPolicyResource) Update(ctx context.Context, req resource.Up
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !nextRunsPlanned(plan) {
+		data.refreshNextRuns(time.Now())
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -364,10 +388,7 @@ func (r *VitessBackupPolicyResource) Delete(ctx context.Context, req resource.De
 	}
 	res, err := r.client.BackupPolicies.DeleteVitessBackupPolicy(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -378,7 +399,7 @@ func (r *VitessBackupPolicyResource) Delete(ctx context.Context, req resource.De
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
@@ -20,6 +20,7 @@ import (
 	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
 	custom_stringvalidators "github.com/planetscale/terraform-provider-planetscale/internal/validators/stringvalidators"
 )
 
@@ -53,6 +54,7 @@ type VitessBranchResourceModel struct {
 	Name                       types.String                       `tfsdk:"name"`
 	Organization               types.String                       `tfsdk:"organization"`
 	ParentBranch               types.String                       `tfsdk:"parent_branch"`
+	Production                 types.Bool                         `tfsdk:"production"`
 	Ready                      types.Bool                         `tfsdk:"ready"`
 	Region                     types.String                       `tfsdk:"region"`
 	RegionData                 *tfTypes.GetVitessBranchRegionData `tfsdk:"region_data"`
@@ -97,26 +99,29 @@ func (r *VitessBranchResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed: true,
 				Optional: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
 					speakeasy_stringplanmodifier.SuppressDiff(speakeasy_stringplanmodifier.ExplicitSuppress),
 				},
-				Description: `The database cluster size. Required if a backup_id is provided (unless keyspace_cluster_sizes covers every keyspace), optional otherwise. Options: PS_10, PS_20, PS_40, ..., PS_2800. Requires replacement if changed.`,
+				Description: `The database cluster size. Required if a backup_id is provided (unless keyspace_cluster_sizes covers every keyspace), optional otherwise. Options: PS_10, PS_20, PS_40, ..., PS_2800. Changing it resizes the default keyspace of the branch in place.`,
 				Validators: []validator.String{
 					custom_stringvalidators.VitessClusterSizeValidator(),
 				},
 			},
 			"database": schema.StringAttribute{
-				Required:    true,
-				Description: `The name of the database the branch belongs to`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `The name of the database the branch belongs to. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"delete_descendants": schema.BoolAttribute{
 				Optional:    true,
-				Description: `If true, recursively delete all descendant branches along with this branch`,
+				Description: `If true, recursively delete all descendant branches along with this branch. Plans that destroy or replace the branch list the descendants that would be deleted`,
 			},
 			"deletion_protected": schema.BoolAttribute{
 				Computed:    true,
 				Optional:    true,
-				Description: `Whether deletion protection is enabled for the branch`,
+				Description: `Whether deletion protection is enabled for the branch. A protected branch is not destroyed or replaced by Terraform, and a protected branch deleted outside of Terraform is reported as an error instead of being recreated.`,
 			},
 			"html_url": schema.StringAttribute{
 				Computed:    true,
@@ -143,8 +148,12 @@ func (r *VitessBranchResource) Schema(ctx context.Context, req resource.SchemaRe
 				Description: `The name of the branch to create`,
 			},
 			"organization": schema.StringAttribute{
-				Required:    true,
-				Description: `The name of the organization the branch belongs to`,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Description: `The name of the organization the branch belongs to. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"parent_branch": schema.StringAttribute{
 				Computed: true,
@@ -155,6 +164,11 @@ func (r *VitessBranchResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 				Description: `The name of the parent branch. Defaults to the database's default branch if not provided. Requires replacement if changed.`,
 			},
+			"production": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: `Whether the branch is a production branch. Changing it promotes or demotes the branch in place, and safe migrations are enabled only after a promotion has finished`,
+			},
 			"ready": schema.BoolAttribute{
 				Computed:    true,
 				Description: `Whether or not the branch is ready to serve queries`,
@@ -283,10 +297,7 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	res, err := r.client.DatabaseBranches.CreateVitessBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -294,7 +305,7 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	if res.StatusCode != 201 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -319,16 +330,17 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	getVitessBranchProgress := newWaitProgress("Vitess branch to be ready", typicalBranchReadyDuration)
 	getVitessBranchOptions := make([]operations.Option, 0, 1)
 	getVitessBranchOptions = append(getVitessBranchOptions, operations.WithPolling(
 		r.client.DatabaseBranches.GetVitessBranchWaitForReady(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getVitessBranchProgress.Observe),
 	))
 	res1, err := r.client.DatabaseBranches.GetVitessBranch(ctx, *request1, getVitessBranchOptions...)
+	resp.Diagnostics.Append(getVitessBranchProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -336,52 +348,55 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
 		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res1.RawResponse))
 		return
 	}
+	plannedProduction := data.Production
 	resp.Diagnostics.Append(data.RefreshFromOperationsGetVitessBranchResponseBody(ctx, res1.Object)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+	// Safe migrations can only be enabled on a production branch, so the
+	// promotion has to finish first. The plan is reapplied once both the
+	// ready and the promoted branch have been read.
+	resp.Diagnostics.Append(r.changeProduction(ctx, data, plannedProductionChange(plannedProduction, res1.Object.Production))...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	request2, request2Diags := data.ToOperationsUpdateSafeMigrationsRequest(ctx)
-	resp.Diagnostics.Append(request2Diags...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	res2, err := r.client.DatabaseBranches.UpdateSafeMigrations(ctx, *request2)
-	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res2 != nil && res2.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res2.RawResponse))
-		}
-		return
-	}
-	if res2 == nil {
-		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res2))
-		return
-	}
-	if res2.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res2.StatusCode), debugResponse(res2.RawResponse))
-		return
-	}
 
 	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data.SafeMigrations.ValueBool() {
+		request2, request2Diags := data.ToOperationsUpdateSafeMigrationsRequest(ctx)
+		resp.Diagnostics.Append(request2Diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res2, err := r.client.DatabaseBranches.UpdateSafeMigrations(ctx, *request2)
+		if err != nil {
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
+			return
+		}
+		if res2 == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res2))
+			return
+		}
+		if res2.StatusCode != 200 {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
+			return
+		}
+	}
 	request3, request3Diags := data.ToOperationsUpdateBranchResizeRequestRequest(ctx)
 	resp.Diagnostics.Append(request3Diags...)
 
@@ -390,10 +405,7 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	res3, err := r.client.APIBranchResizes.UpdateBranchResizeRequest(ctx, *request3)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res3 != nil && res3.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res3.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res3 == nil {
@@ -404,7 +416,7 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 	case 200, 204:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res3.StatusCode), debugResponse(res3.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res3.RawResponse)...)
 		return
 	}
 	if !(res3.Object != nil) {
@@ -429,16 +441,17 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	getBranchResizeRequestProgress := newWaitProgress("branch resize to complete", typicalBranchResizeDuration)
 	getBranchResizeRequestOptions := make([]operations.Option, 0, 1)
 	getBranchResizeRequestOptions = append(getBranchResizeRequestOptions, operations.WithPolling(
 		r.client.APIBranchResizes.GetBranchResizeRequestWaitForResizeComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getBranchResizeRequestProgress.Observe),
 	))
 	res4, err := r.client.APIBranchResizes.GetBranchResizeRequest(ctx, *request4, getBranchResizeRequestOptions...)
+	resp.Diagnostics.Append(getBranchResizeRequestProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res4 != nil && res4.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res4.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res4 == nil {
@@ -446,7 +459,7 @@ func (r *VitessBranchResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 	if res4.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res4.StatusCode), debugResponse(res4.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res4.RawResponse)...)
 		return
 	}
 	if !(res4.Object != nil) {
@@ -495,22 +508,23 @@ func (r *VitessBranchResource) Read(ctx context.Context, req resource.ReadReques
 	}
 	res, err := r.client.DatabaseBranches.GetVitessBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundProtectedResource(ctx, resp, data.DeletionProtected)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundProtectedResource(ctx, resp, data.DeletionProtected)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -541,6 +555,28 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	var production, safeMigrations types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("production"), &production)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("safe_migrations"), &safeMigrations)...)
+	productionChange := plannedProductionChange(data.Production, production.ValueBool())
+
+	var clusterSize types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cluster_size"), &clusterSize)...)
+
+	resp.Diagnostics.Append(r.waitForInFlightBranchResize(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plannedClusterSizeChange(data.ClusterSize, clusterSize) {
+		resp.Diagnostics.Append(r.resizeDefaultKeyspace(ctx, data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	request, requestDiags := data.ToOperationsUpdateBranchResizeRequestRequest(ctx)
 	resp.Diagnostics.Append(requestDiags...)
 
@@ -549,10 +585,7 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	res, err := r.client.APIBranchResizes.UpdateBranchResizeRequest(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -563,7 +596,7 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 	case 200, 204:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -588,16 +621,17 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	getBranchResizeRequestProgress := newWaitProgress("branch resize to complete", typicalBranchResizeDuration)
 	getBranchResizeRequestOptions := make([]operations.Option, 0, 1)
 	getBranchResizeRequestOptions = append(getBranchResizeRequestOptions, operations.WithPolling(
 		r.client.APIBranchResizes.GetBranchResizeRequestWaitForResizeComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getBranchResizeRequestProgress.Observe),
 	))
 	res1, err := r.client.APIBranchResizes.GetBranchResizeRequest(ctx, *request1, getBranchResizeRequestOptions...)
+	resp.Diagnostics.Append(getBranchResizeRequestProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -605,7 +639,7 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -623,33 +657,42 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	request2, request2Diags := data.ToOperationsUpdateSafeMigrationsRequest(ctx)
-	resp.Diagnostics.Append(request2Diags...)
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	res2, err := r.client.DatabaseBranches.UpdateSafeMigrations(ctx, *request2)
-	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res2 != nil && res2.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res2.RawResponse))
+	// Promote before enabling safe migrations, and only demote once they
+	// have been turned off below.
+	if productionChange == branchPromotion {
+		resp.Diagnostics.Append(r.changeProduction(ctx, data, productionChange)...)
+
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		return
-	}
-	if res2 == nil {
-		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res2))
-		return
-	}
-	if res2.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res2.StatusCode), debugResponse(res2.RawResponse))
-		return
-	}
 
-	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+		resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
 
-	if resp.Diagnostics.HasError() {
-		return
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !data.SafeMigrations.Equal(safeMigrations) {
+		request2, request2Diags := data.ToOperationsUpdateSafeMigrationsRequest(ctx)
+		resp.Diagnostics.Append(request2Diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res2, err := r.client.DatabaseBranches.UpdateSafeMigrations(ctx, *request2)
+		if err != nil {
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
+			return
+		}
+		if res2 == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res2))
+			return
+		}
+		if res2.StatusCode != 200 {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
+			return
+		}
 	}
 	request3, request3Diags := data.ToOperationsUpdateVitessBranchRequest(ctx)
 	resp.Diagnostics.Append(request3Diags...)
@@ -659,10 +702,7 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	res3, err := r.client.DatabaseBranches.UpdateVitessBranch(ctx, *request3)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res3 != nil && res3.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res3.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res3 == nil {
@@ -670,7 +710,7 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 	if res3.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res3.StatusCode), debugResponse(res3.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res3.RawResponse)...)
 		return
 	}
 	if !(res3.Object != nil) {
@@ -689,6 +729,20 @@ func (r *VitessBranchResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if productionChange == branchDemotion {
+		resp.Diagnostics.Append(r.changeProduction(ctx, data, productionChange)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -711,6 +765,11 @@ func (r *VitessBranchResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if data.DeletionProtected.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectedBranchError(data.Name)...)
+		return
+	}
+
 	request, requestDiags := data.ToOperationsDeleteVitessBranchRequest(ctx)
 	resp.Diagnostics.Append(requestDiags...)
 
@@ -719,10 +778,7 @@ func (r *VitessBranchResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 	res, err := r.client.DatabaseBranches.DeleteVitessBranch(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -733,7 +789,7 @@ func (r *VitessBranchResource) Delete(ctx context.Context, req resource.DeleteRe
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
@@ -2,11 +2,16 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/require"
@@ -103,3 +108,79 @@ func TestVitessBranchResource_BranchSettingsAreConfigurable(t *testing.T) {
 	require.True(t, vtgateSize.Optional)
 	require.True(t, vtgateSize.Computed)
 }
+
+func TestVitessBranchResource_ClusterSizeResizesInPlace(t *testing.T) {
+	t.Parallel()
+
+	r := NewVitessBranchResource()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	clusterSizeAttr, ok := schemaResp.Schema.Attributes["cluster_size"].(schema.StringAttribute)
+	require.True(t, ok)
+
+	req := planmodifier.StringRequest{
+		Path:        path.Root("cluster_size"),
+		ConfigValue: types.StringValue("PS_20"),
+		PlanValue:   types.StringValue("PS_20"),
+		StateValue:  types.StringValue("PS_10"),
+	}
+	for _, modifier := range clusterSizeAttr.PlanModifiers {
+		resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+		modifier.PlanModifyString(context.Background(), req, &resp)
+		require.False(t, resp.RequiresReplace, modifier.Description(context.Background()))
+	}
+
+	require.True(t, plannedClusterSizeChange(types.StringValue("PS_20"), types.StringValue("PS_10")))
+	require.False(t, plannedClusterSizeChange(types.StringValue("PS_10"), types.StringValue("PS_10")))
+	require.False(t, plannedClusterSizeChange(types.StringUnknown(), types.StringValue("PS_10")))
+	require.False(t, plannedClusterSizeChange(types.StringNull(), types.StringValue("PS_10")))
+}
+
+func TestVitessBranchResource_ResizeDefaultKeyspace(t *testing.T) {
+	t.Parallel()
+
+	const keyspacesPath = "/organizations/org/databases/db/branches/main/keyspaces"
+
+	var (
+		mu      sync.Mutex
+		resized []string
+	)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) error {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == keyspacesPath:
+			data := "[]"
+			if page := r.URL.Query().Get("page"); page == "" || page == "1" {
+				data = `[{"id": "ks-1", "name": "sharded", "default": false}, {"id": "ks-2", "name": "main", "default": true}]`
+			}
+			writeJSON(w, `{"type": "list", "current_page": 1, "data": `+data+`}`)
+		case r.Method == http.MethodPut && r.URL.Path == keyspacesPath+"/main/resizes":
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			resized = append(resized, string(body))
+			mu.Unlock()
+			writeJSON(w, `{"id": "resize-1", "state": "pending"}`)
+		case r.Method == http.MethodGet && r.URL.Path == keyspacesPath+"/main/resizes/resize-1":
+			writeJSON(w, `{"id": "resize-1", "state": "completed"}`)
+		default:
+			return errors.New("unexpected request")
+		}
+		return nil
+	})
+
+	r := &VitessBranchResource{client: client}
+	diags := r.resizeDefaultKeyspace(context.Background(), &VitessBranchResourceModel{
+		Organization: types.StringValue("org"),
+		Database:     types.StringValue("db"),
+		Name:         types.StringValue("main"),
+		ClusterSize:  types.StringValue("PS_20"),
+	})
+	require.False(t, diags.HasError(), diags)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{`{"cluster_size":"PS_20"}`}, resized)
+}
@@ -77,6 +77,7 @@ func (r *VitessBranchResourceModel) RefreshFromOperationsGetVitessBranchResponse
 		r.MysqlEdgeAddress = types.StringValue(resp.MysqlEdgeAddress)
 		r.Name = types.StringValue(resp.Name)
 		r.ParentBranch = types.StringPointerValue(resp.ParentBranch)
+		r.Production = types.BoolValue(resp.Production)
 		r.Ready = types.BoolValue(resp.Ready)
 		r.RegionData = &tfTypes.GetVitessBranchRegionData{}
 		r.RegionData.ID = types.StringValue(resp.RegionData.ID)
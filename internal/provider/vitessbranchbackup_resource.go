@@ -21,6 +21,7 @@ import (
 	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -48,6 +49,7 @@ type VitessBranchBackupResourceModel struct {
 	DeletedAt            types.String                                 `tfsdk:"deleted_at"`
 	EstimatedStorageCost types.Float64                                `tfsdk:"estimated_storage_cost"`
 	ExpiresAt            types.String                                 `tfsdk:"expires_at"`
+	ForceDelete          types.Bool                                   `tfsdk:"force_delete"`
 	ID                   types.String                                 `tfsdk:"id"`
 	Name                 types.String                                 `tfsdk:"name"`
 	Organization         types.String                                 `tfsdk:"organization"`
@@ -99,11 +101,12 @@ func (r *VitessBranchBackupResource) Schema(ctx context.Context, req resource.Sc
 				Description: `When the backup completed`,
 			},
 			"database": schema.StringAttribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `The name of the database the branch belongs to. Requires replacement if changed.`,
+				Description: `The name of the database the branch belongs to. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"database_branch": schema.SingleNestedAttribute{
 				Computed: true,
@@ -130,6 +133,10 @@ func (r *VitessBranchBackupResource) Schema(ctx context.Context, req resource.Sc
 				Computed:    true,
 				Description: `When the backup expires`,
 			},
+			"force_delete": schema.BoolAttribute{
+				Optional:    true,
+				Description: `Whether to delete the backup even if it is protected. Protection is removed before the backup is deleted.`,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -147,15 +154,17 @@ func (r *VitessBranchBackupResource) Schema(ctx context.Context, req resource.Sc
 				Description: `Name for the backup. Requires replacement if changed.`,
 			},
 			"organization": schema.StringAttribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `The name of the organization the branch belongs to. Requires replacement if changed.`,
+				Description: `The name of the organization the branch belongs to. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"protected": schema.BoolAttribute{
 				Computed:    true,
-				Description: `Whether or not the backup is protected from deletion`,
+				Optional:    true,
+				Description: `Whether or not the backup is protected from deletion. Can be changed without replacing the backup. A protected backup is only deleted when ` + "`" + `force_delete` + "`" + ` is set.`,
 			},
 			"pvc_size": schema.Int64Attribute{
 				Computed:    true,
@@ -250,10 +259,7 @@ func (r *VitessBranchBackupResource) Create(ctx context.Context, req resource.Cr
 	}
 	res, err := r.client.Backups.CreateVitessBranchBackup(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -261,7 +267,7 @@ func (r *VitessBranchBackupResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	if res.StatusCode != 201 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -286,16 +292,17 @@ func (r *VitessBranchBackupResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	getVitessBranchBackupProgress := newWaitProgress("backup to complete", typicalBackupCompleteDuration)
 	getVitessBranchBackupOptions := make([]operations.Option, 0, 1)
 	getVitessBranchBackupOptions = append(getVitessBranchBackupOptions, operations.WithPolling(
 		r.client.Backups.GetVitessBranchBackupWaitForComplete(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getVitessBranchBackupProgress.Observe),
 	))
 	res1, err := r.client.Backups.GetVitessBranchBackup(ctx, *request1, getVitessBranchBackupOptions...)
+	resp.Diagnostics.Append(getVitessBranchBackupProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -303,7 +310,7 @@ func (r *VitessBranchBackupResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -321,6 +328,42 @@ func (r *VitessBranchBackupResource) Create(ctx context.Context, req resource.Cr
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data.Protected.ValueBool() && !res1.Object.Protected {
+		request2, request2Diags := data.ToOperationsUpdateVitessBranchBackupRequest(ctx)
+		resp.Diagnostics.Append(request2Diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		res2, err := r.client.Backups.UpdateVitessBranchBackup(ctx, *request2)
+		if err != nil {
+			resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
+			return
+		}
+		if res2 == nil {
+			resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res2))
+			return
+		}
+		if res2.StatusCode != 200 {
+			resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res2.RawResponse)...)
+			return
+		}
+		if !(res2.Object != nil) {
+			resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res2.RawResponse))
+			return
+		}
+		resp.Diagnostics.Append(data.RefreshFromOperationsUpdateVitessBranchBackupResponseBody(ctx, res2.Object)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -352,22 +395,23 @@ func (r *VitessBranchBackupResource) Read(ctx context.Context, req resource.Read
 	}
 	res, err := r.client.Backups.GetVitessBranchBackup(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundResource(ctx, resp)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundResource(ctx, resp)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -398,7 +442,40 @@ func (r *VitessBranchBackupResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	// Not Implemented; all attributes marked as RequiresReplace
+	request, requestDiags := data.ToOperationsUpdateVitessBranchBackupRequest(ctx)
+	resp.Diagnostics.Append(requestDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := r.client.Backups.UpdateVitessBranchBackup(ctx, *request)
+	if err != nil {
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
+		return
+	}
+	if res.StatusCode != 200 {
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
+		return
+	}
+	if !(res.Object != nil) {
+		resp.Diagnostics.AddError("unexpected response from API. Got an unexpected response body", debugResponse(res.RawResponse))
+		return
+	}
+	resp.Diagnostics.Append(data.RefreshFromOperationsUpdateVitessBranchBackupResponseBody(ctx, res.Object)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -422,6 +499,12 @@ func (r *VitessBranchBackupResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	resp.Diagnostics.Append(r.unprotectForDelete(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, requestDiags := data.ToOperationsDeleteVitessBranchBackupRequest(ctx)
 	resp.Diagnostics.Append(requestDiags...)
 
@@ -430,10 +513,7 @@ func (r *VitessBranchBackupResource) Delete(ctx context.Context, req resource.De
 	}
 	res, err := r.client.Backups.DeleteVitessBranchBackup(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -444,7 +524,7 @@ func (r *VitessBranchBackupResource) Delete(ctx context.Context, req resource.De
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
@@ -90,6 +90,46 @@ func (r *VitessBranchBackupResourceModel) RefreshFromOperationsGetVitessBranchBa
 	return diags
 }
 
+func (r *VitessBranchBackupResourceModel) RefreshFromOperationsUpdateVitessBranchBackupResponseBody(ctx context.Context, resp *operations.UpdateVitessBranchBackupResponseBody) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if resp != nil {
+		if resp.Actor == nil {
+			r.Actor = nil
+		} else {
+			r.Actor = &tfTypes.GetVitessBranchBackupActor{}
+			r.Actor.ID = types.StringValue(resp.Actor.ID)
+		}
+		if resp.BackupPolicy == nil {
+			r.BackupPolicy = nil
+		} else {
+			r.BackupPolicy = &tfTypes.GetVitessBranchBackupBackupPolicy{}
+			r.BackupPolicy.ID = types.StringValue(resp.BackupPolicy.ID)
+		}
+		r.CompletedAt = types.StringPointerValue(resp.CompletedAt)
+		if resp.DatabaseBranch == nil {
+			r.DatabaseBranch = nil
+		} else {
+			r.DatabaseBranch = &tfTypes.GetVitessBranchBackupDatabaseBranch{}
+			r.DatabaseBranch.ID = types.StringValue(resp.DatabaseBranch.ID)
+			r.DatabaseBranch.Name = types.StringValue(resp.DatabaseBranch.Name)
+		}
+		r.DeletedAt = types.StringPointerValue(resp.DeletedAt)
+		r.EstimatedStorageCost = types.Float64Value(resp.EstimatedStorageCost)
+		r.ExpiresAt = types.StringPointerValue(resp.ExpiresAt)
+		r.ID = types.StringValue(resp.ID)
+		r.Name = types.StringValue(resp.Name)
+		r.Protected = types.BoolValue(resp.Protected)
+		r.PvcSize = types.Int64Value(resp.PvcSize)
+		r.Size = types.Int64Value(resp.Size)
+		r.StartedAt = types.StringPointerValue(resp.StartedAt)
+		r.State = types.StringValue(string(resp.State))
+		r.UncompressedSize = types.Int64Value(resp.UncompressedSize)
+	}
+
+	return diags
+}
+
 func (r *VitessBranchBackupResourceModel) ToOperationsCreateVitessBranchBackupRequest(ctx context.Context) (*operations.CreateVitessBranchBackupRequest, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -198,3 +238,52 @@ func (r *VitessBranchBackupResourceModel) ToOperationsGetVitessBranchBackupReque
 
 	return &out, diags
 }
+
+func (r *VitessBranchBackupResourceModel) ToOperationsUpdateVitessBranchBackupRequest(ctx context.Context) (*operations.UpdateVitessBranchBackupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var id string
+	id = r.ID.ValueString()
+
+	var organization string
+	organization = r.Organization.ValueString()
+
+	var database string
+	database = r.Database.ValueString()
+
+	var branch string
+	branch = r.Branch.ValueString()
+
+	body, bodyDiags := r.ToOperationsUpdateVitessBranchBackupRequestBody(ctx)
+	diags.Append(bodyDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := operations.UpdateVitessBranchBackupRequest{
+		ID:           id,
+		Organization: organization,
+		Database:     database,
+		Branch:       branch,
+		Body:         body,
+	}
+
+	return &out, diags
+}
+
+func (r *VitessBranchBackupResourceModel) ToOperationsUpdateVitessBranchBackupRequestBody(ctx context.Context) (*operations.UpdateVitessBranchBackupRequestBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	protected := new(bool)
+	if !r.Protected.IsUnknown() && !r.Protected.IsNull() {
+		*protected = r.Protected.ValueBool()
+	} else {
+		protected = nil
+	}
+	out := operations.UpdateVitessBranchBackupRequestBody{
+		Protected: protected,
+	}
+
+	return &out, diags
+}
@@ -21,6 +21,7 @@ import (
 	tfTypes "github.com/planetscale/terraform-provider-planetscale/internal/provider/types"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/operations"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
 	custom_stringvalidators "github.com/planetscale/terraform-provider-planetscale/internal/validators/stringvalidators"
 )
 
@@ -102,11 +103,12 @@ func (r *VitessKeyspaceResource) Schema(ctx context.Context, req resource.Schema
 				Description: `When the keyspace was created`,
 			},
 			"database": schema.StringAttribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `The name of the database the branch belongs to. Requires replacement if changed.`,
+				Description: `The name of the database the branch belongs to. Defaults to the provider ` + "`" + `database` + "`" + `. Requires replacement if changed.`,
 			},
 			"extra_replicas": schema.Int64Attribute{
 				Computed:    true,
@@ -142,11 +144,12 @@ func (r *VitessKeyspaceResource) Schema(ctx context.Context, req resource.Schema
 				Description: `Controls when node TTL drains are allowed`,
 			},
 			"organization": schema.StringAttribute{
-				Required: true,
+				Computed: true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
-				Description: `The name of the organization the branch belongs to. Requires replacement if changed.`,
+				Description: `The name of the organization the branch belongs to. Defaults to the provider ` + "`" + `organization` + "`" + `. Requires replacement if changed.`,
 			},
 			"ready": schema.BoolAttribute{
 				Computed:    true,
@@ -265,10 +268,7 @@ func (r *VitessKeyspaceResource) Create(ctx context.Context, req resource.Create
 	}
 	res, err := r.client.DatabaseBranchKeyspaces.CreateKeyspace(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -276,7 +276,7 @@ func (r *VitessKeyspaceResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -301,16 +301,17 @@ func (r *VitessKeyspaceResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	getKeyspaceProgress := newWaitProgress("keyspace to be ready", typicalKeyspaceReadyDuration)
 	getKeyspaceOptions := make([]operations.Option, 0, 1)
 	getKeyspaceOptions = append(getKeyspaceOptions, operations.WithPolling(
 		r.client.DatabaseBranchKeyspaces.GetKeyspaceWaitForReady(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getKeyspaceProgress.Observe),
 	))
 	res1, err := r.client.DatabaseBranchKeyspaces.GetKeyspace(ctx, *request1, getKeyspaceOptions...)
+	resp.Diagnostics.Append(getKeyspaceProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -318,7 +319,7 @@ func (r *VitessKeyspaceResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -367,22 +368,23 @@ func (r *VitessKeyspaceResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 	res, err := r.client.DatabaseBranchKeyspaces.GetKeyspace(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
+		if isNotFoundError(err) {
+			removeNotFoundResource(ctx, resp)
+			return
 		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
 		resp.Diagnostics.AddError("unexpected response from API", fmt.Sprintf("%v", res))
 		return
 	}
-	if res.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+	if isNotFoundResponse(res.RawResponse) {
+		removeNotFoundResource(ctx, resp)
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -413,6 +415,12 @@ func (r *VitessKeyspaceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resp.Diagnostics.Append(r.waitForInFlightKeyspaceChange(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	request, requestDiags := data.ToOperationsUpdateKeyspaceResizeRequestRequest(ctx)
 	resp.Diagnostics.Append(requestDiags...)
 
@@ -421,10 +429,7 @@ func (r *VitessKeyspaceResource) Update(ctx context.Context, req resource.Update
 	}
 	res, err := r.client.KeyspaceResizes.UpdateKeyspaceResizeRequest(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -432,7 +437,7 @@ func (r *VitessKeyspaceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	if res.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res.RawResponse)...)
 		return
 	}
 	if !(res.Object != nil) {
@@ -440,6 +445,12 @@ func (r *VitessKeyspaceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resp.Diagnostics.Append(r.waitForKeyspaceResize(ctx, data, res.Object.ResizeRequestID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(refreshPlan(ctx, plan, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -452,16 +463,17 @@ func (r *VitessKeyspaceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	getKeyspaceProgress := newWaitProgress("keyspace to be ready", typicalKeyspaceReadyDuration)
 	getKeyspaceOptions := make([]operations.Option, 0, 1)
 	getKeyspaceOptions = append(getKeyspaceOptions, operations.WithPolling(
 		r.client.DatabaseBranchKeyspaces.GetKeyspaceWaitForReady(),
+		withPollingBackoff,
+		polling.WithAttemptHook(getKeyspaceProgress.Observe),
 	))
 	res1, err := r.client.DatabaseBranchKeyspaces.GetKeyspace(ctx, *request1, getKeyspaceOptions...)
+	resp.Diagnostics.Append(getKeyspaceProgress.Diagnostics()...)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res1 != nil && res1.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res1.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.Plan.Schema, err)...)
 		return
 	}
 	if res1 == nil {
@@ -469,7 +481,7 @@ func (r *VitessKeyspaceResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	if res1.StatusCode != 200 {
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res1.StatusCode), debugResponse(res1.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.Plan.Schema, res1.RawResponse)...)
 		return
 	}
 	if !(res1.Object != nil) {
@@ -518,10 +530,7 @@ func (r *VitessKeyspaceResource) Delete(ctx context.Context, req resource.Delete
 	}
 	res, err := r.client.DatabaseBranchKeyspaces.DeleteKeyspace(ctx, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("failure to invoke API", err.Error())
-		if res != nil && res.RawResponse != nil {
-			resp.Diagnostics.AddError("unexpected http request/response", debugResponse(res.RawResponse))
-		}
+		resp.Diagnostics.Append(apiInvokeErrorDiagnostics(ctx, req.State.Schema, err)...)
 		return
 	}
 	if res == nil {
@@ -532,7 +541,7 @@ func (r *VitessKeyspaceResource) Delete(ctx context.Context, req resource.Delete
 	case 204, 404:
 		break
 	default:
-		resp.Diagnostics.AddError(fmt.Sprintf("unexpected response from API. Got an unexpected response code %v", res.StatusCode), debugResponse(res.RawResponse))
+		resp.Diagnostics.Append(apiResponseDiagnostics(ctx, req.State.Schema, res.RawResponse)...)
 		return
 	}
 
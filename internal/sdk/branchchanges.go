@@ -14,7 +14,6 @@ import (
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
 	"net/http"
 	"strings"
-	"time"
 )
 
 // BranchChanges -           Resources for managing cluster changes.
@@ -208,9 +207,9 @@ func (s *BranchChanges) getBranchChangeRequest(ctx context.Context, hookCtx hook
 //     override the predefined limit.
 func (s *BranchChanges) GetBranchChangeRequestWaitForChangeRequestComplete() polling.ConfigFunc {
 	return func(pollingOpts ...polling.Option) (*polling.Config, error) {
-		defaultDelaySeconds := 30
-		defaultIntervalSeconds := 10
-		defaultLimitCount := 90
+		defaultDelaySeconds := 5
+		defaultIntervalSeconds := 5
+		defaultLimitCount := 360
 		result := &polling.Config{
 			DelaySeconds:    &defaultDelaySeconds,
 			IntervalSeconds: &defaultIntervalSeconds,
@@ -233,8 +232,9 @@ func (s *BranchChanges) getBranchChangeRequestWaitForChangeRequestComplete(ctx c
 		return s.getBranchChangeRequest(ctx, hookCtx, req, o)
 	}
 
-	if o.Polling.DelaySeconds != nil {
-		time.Sleep(time.Duration(*o.Polling.DelaySeconds) * time.Second)
+	poller := polling.NewPoller(o.Polling)
+	if err := poller.Delay(ctx); err != nil {
+		return nil, err
 	}
 
 	var res *operations.GetBranchChangeRequestResponse
@@ -259,6 +259,12 @@ func (s *BranchChanges) getBranchChangeRequestWaitForChangeRequestComplete(ctx c
 			return res, err
 		}
 
+		pollingState := ""
+		if res.Object != nil {
+			pollingState = string(res.Object.ChangeRequestState)
+		}
+		poller.Observe(ctx, res.StatusCode, pollingState)
+
 		failureCriteriaMessage := make([]string, 0, 2)
 		failureCriteriaMet := true
 
@@ -290,8 +296,8 @@ func (s *BranchChanges) getBranchChangeRequestWaitForChangeRequestComplete(ctx c
 			return res, nil
 		}
 
-		if o.Polling.IntervalSeconds != nil {
-			time.Sleep(time.Duration(*o.Polling.IntervalSeconds) * time.Second)
+		if err := poller.Wait(ctx); err != nil {
+			return res, err
 		}
 	}
 
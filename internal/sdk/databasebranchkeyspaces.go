@@ -14,7 +14,6 @@ import (
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/polling"
 	"github.com/spyzhov/ajson"
 	"net/http"
-	"time"
 )
 
 // DatabaseBranchKeyspaces -           Resources for managing keyspaces.
@@ -532,9 +531,9 @@ func (s *DatabaseBranchKeyspaces) getKeyspace(ctx context.Context, hookCtx hooks
 //     override the predefined limit.
 func (s *DatabaseBranchKeyspaces) GetKeyspaceWaitForReady() polling.ConfigFunc {
 	return func(pollingOpts ...polling.Option) (*polling.Config, error) {
-		defaultDelaySeconds := 30
-		defaultIntervalSeconds := 10
-		defaultLimitCount := 180
+		defaultDelaySeconds := 5
+		defaultIntervalSeconds := 5
+		defaultLimitCount := 720
 		result := &polling.Config{
 			DelaySeconds:    &defaultDelaySeconds,
 			IntervalSeconds: &defaultIntervalSeconds,
@@ -557,8 +556,9 @@ func (s *DatabaseBranchKeyspaces) getKeyspaceWaitForReady(ctx context.Context, h
 		return s.getKeyspace(ctx, hookCtx, req, o)
 	}
 
-	if o.Polling.DelaySeconds != nil {
-		time.Sleep(time.Duration(*o.Polling.DelaySeconds) * time.Second)
+	poller := polling.NewPoller(o.Polling)
+	if err := poller.Delay(ctx); err != nil {
+		return nil, err
 	}
 
 	var res *operations.GetKeyspaceResponse
@@ -583,6 +583,12 @@ func (s *DatabaseBranchKeyspaces) getKeyspaceWaitForReady(ctx context.Context, h
 			return res, err
 		}
 
+		pollingState := ""
+		if res.Object != nil {
+			pollingState = res.Object.PollingState()
+		}
+		poller.Observe(ctx, res.StatusCode, pollingState)
+
 		successCriteriaMet := true
 
 		if successCriteriaMet {
@@ -609,14 +615,163 @@ func (s *DatabaseBranchKeyspaces) getKeyspaceWaitForReady(ctx context.Context, h
 			return res, nil
 		}
 
-		if o.Polling.IntervalSeconds != nil {
-			time.Sleep(time.Duration(*o.Polling.IntervalSeconds) * time.Second)
+		if err := poller.Wait(ctx); err != nil {
+			return res, err
 		}
 	}
 
 	return res, &polling.LimitCountError{Limit: *o.Polling.LimitCount}
 }
 
+// GetKeyspaceRolloutStatus - Get keyspace rollout status
+// ### Authorization
+// A service token   must have at least one of the following access   in order to use this API endpoint:
+//
+// **Service Token Accesses**
+//
+//	`read_branch`
+func (s *DatabaseBranchKeyspaces) GetKeyspaceRolloutStatus(ctx context.Context, request operations.GetKeyspaceRolloutStatusRequest, opts ...operations.Option) (*operations.GetKeyspaceRolloutStatusResponse, error) {
+	o := operations.Options{}
+	supportedOptions := []string{
+		operations.SupportedOptionTimeout,
+	}
+
+	for _, opt := range opts {
+		if err := opt(&o, supportedOptions...); err != nil {
+			return nil, fmt.Errorf("error applying option: %w", err)
+		}
+	}
+
+	var baseURL string
+	if o.ServerURL == nil {
+		baseURL = utils.ReplaceParameters(s.sdkConfiguration.GetServerDetails())
+	} else {
+		baseURL = *o.ServerURL
+	}
+	opURL, err := utils.GenerateURL(ctx, baseURL, "/organizations/{organization}/databases/{database}/branches/{branch}/keyspaces/{keyspace}/rollout-status", request, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error generating URL: %w", err)
+	}
+
+	hookCtx := hooks.HookContext{
+		SDK:              s.rootSDK,
+		SDKConfiguration: s.sdkConfiguration,
+		BaseURL:          baseURL,
+		Context:          ctx,
+		OperationID:      "get_keyspace_rollout_status",
+		OAuth2Scopes:     nil,
+		SecuritySource:   s.sdkConfiguration.Security,
+	}
+
+	timeout := o.Timeout
+	if timeout == nil {
+		timeout = s.sdkConfiguration.Timeout
+	}
+
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", opURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", s.sdkConfiguration.UserAgent)
+
+	if err := utils.PopulateSecurity(ctx, req, s.sdkConfiguration.Security); err != nil {
+		return nil, err
+	}
+
+	for k, v := range o.SetHeaders {
+		req.Header.Set(k, v)
+	}
+
+	return s.getKeyspaceRolloutStatus(ctx, hookCtx, req, o)
+}
+
+func (s *DatabaseBranchKeyspaces) getKeyspaceRolloutStatus(ctx context.Context, hookCtx hooks.HookContext, req *http.Request, o operations.Options) (*operations.GetKeyspaceRolloutStatusResponse, error) {
+	var err error
+
+	req, err = s.hooks.BeforeRequest(hooks.BeforeRequestContext{HookContext: hookCtx}, req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := s.sdkConfiguration.Client.Do(req)
+	if err != nil || httpRes == nil {
+		if err != nil {
+			err = fmt.Errorf("error sending request: %w", err)
+		} else {
+			err = fmt.Errorf("error sending request: no response")
+		}
+
+		_, err = s.hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, nil, err)
+		return nil, err
+	} else if utils.MatchStatusCodes([]string{}, httpRes.StatusCode) {
+		_httpRes, err := s.hooks.AfterError(hooks.AfterErrorContext{HookContext: hookCtx}, httpRes, nil)
+		if err != nil {
+			return nil, err
+		} else if _httpRes != nil {
+			httpRes = _httpRes
+		}
+	} else {
+		httpRes, err = s.hooks.AfterSuccess(hooks.AfterSuccessContext{HookContext: hookCtx}, httpRes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &operations.GetKeyspaceRolloutStatusResponse{
+		StatusCode:  httpRes.StatusCode,
+		ContentType: httpRes.Header.Get("Content-Type"),
+		RawResponse: httpRes,
+	}
+
+	switch {
+	case httpRes.StatusCode == 200:
+		switch {
+		case utils.MatchContentType(httpRes.Header.Get("Content-Type"), `application/json`):
+			rawBody, err := utils.ConsumeRawBody(httpRes)
+			if err != nil {
+				return nil, err
+			}
+
+			var out operations.GetKeyspaceRolloutStatusResponseBody
+			if err := utils.UnmarshalJsonFromResponseBody(bytes.NewBuffer(rawBody), &out, ""); err != nil {
+				return nil, err
+			}
+
+			res.Object = &out
+		default:
+			rawBody, err := utils.ConsumeRawBody(httpRes)
+			if err != nil {
+				return nil, err
+			}
+			return nil, errors.NewAPIError(fmt.Sprintf("unknown content-type received: %s", httpRes.Header.Get("Content-Type")), httpRes.StatusCode, string(rawBody), httpRes)
+		}
+	case httpRes.StatusCode == 401:
+		fallthrough
+	case httpRes.StatusCode == 403:
+		fallthrough
+	case httpRes.StatusCode == 404:
+		utils.DrainBody(httpRes)
+	case httpRes.StatusCode == 500:
+		utils.DrainBody(httpRes)
+	default:
+		rawBody, err := utils.ConsumeRawBody(httpRes)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.NewAPIError("unknown status code returned", httpRes.StatusCode, string(rawBody), httpRes)
+	}
+
+	return res, nil
+
+}
+
 // DeleteKeyspace - Delete a keyspace
 // ### Authorization
 // A service token   must have at least one of the following access   in order to use this API endpoint:
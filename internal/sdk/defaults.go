@@ -0,0 +1,20 @@
+package sdk
+
+// Defaults are provider level values that resources use for attributes left
+// out of their configuration.
+type Defaults struct {
+	Organization string
+	Database     string
+}
+
+// WithDefaults sets the provider level defaults returned by Defaults
+func WithDefaults(defaults Defaults) SDKOption {
+	return func(sdk *PlanetScale) {
+		sdk.defaults = defaults
+	}
+}
+
+// Defaults returns the provider level defaults the SDK was configured with.
+func (s *PlanetScale) Defaults() Defaults {
+	return s.defaults
+}
@@ -0,0 +1,14 @@
+package sdk
+
+import (
+	"net/http"
+
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/internal/hooks"
+)
+
+// ErrorResponseBody returns the body of an error response returned by an
+// operation. Operations drain the body of error statuses they have no model
+// for, so it cannot be read from the response itself.
+func ErrorResponseBody(res *http.Response) []byte {
+	return hooks.ErrorResponseBody(res)
+}
@@ -0,0 +1,27 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/shared"
+)
+
+// ServiceTokenID returns the ID of the service token the SDK authenticates
+// with, or an empty string when the SDK is not configured with one.
+func (s *PlanetScale) ServiceTokenID(ctx context.Context) string {
+	if s.sdkConfiguration.Security == nil {
+		return ""
+	}
+
+	securityObj, err := s.sdkConfiguration.Security(ctx)
+	if err != nil {
+		return ""
+	}
+
+	security, ok := securityObj.(shared.Security)
+	if !ok {
+		return ""
+	}
+
+	return security.GetServiceTokenID()
+}
@@ -2,7 +2,6 @@ package hooks
 
 import (
 	"errors"
-	"log"
 	"net/http"
 
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/shared"
@@ -12,12 +11,12 @@ import (
 //
 // Supports:
 //   - Setting HTTP Authorization header using Service Token ID and Service Token.
+//   - Setting HTTP Authorization header to a Bearer OAuth access token.
 //
-// This can be extended in the future to support additional security mechanisms.
+// A service token takes precedence when both are configured.
 type CustomSecurityHook struct{}
 
 func (i *CustomSecurityHook) BeforeRequest(hookCtx BeforeRequestContext, req *http.Request) (*http.Request, error) {
-	logger := log.Default()
 	securityObj, err := hookCtx.SDKConfiguration.Security(req.Context())
 
 	if err != nil {
@@ -32,13 +31,16 @@ func (i *CustomSecurityHook) BeforeRequest(hookCtx BeforeRequestContext, req *ht
 
 	serviceToken := security.GetServiceToken()
 	serviceTokenID := security.GetServiceTokenID()
-
-	if serviceToken == "" || serviceTokenID == "" {
-		return nil, errors.New("missing Service Token and Service Token ID credentials")
+	accessToken := security.GetAccessToken()
+
+	switch {
+	case serviceToken != "" && serviceTokenID != "":
+		req.Header.Set("Authorization", serviceTokenID+":"+serviceToken)
+	case accessToken != "":
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	default:
+		return nil, errors.New("missing Service Token and Service Token ID, or OAuth access token, credentials")
 	}
 
-	logger.Printf("CustomSecurityHook: Setting HTTP Authorization header with Service Token ID: %s", serviceTokenID)
-	req.Header.Set("Authorization", serviceTokenID+":"+serviceToken)
-
 	return req, nil
 }
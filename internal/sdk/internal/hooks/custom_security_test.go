@@ -0,0 +1,60 @@
+package hooks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/internal/config"
+	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/models/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func customSecurityHookContext(security shared.Security) BeforeRequestContext {
+	return BeforeRequestContext{HookContext: HookContext{
+		SDKConfiguration: config.SDKConfiguration{
+			Security: func(context.Context) (interface{}, error) {
+				return security, nil
+			},
+		},
+	}}
+}
+
+func TestCustomSecurityHookAuthorizationScheme(t *testing.T) {
+	testCases := map[string]struct {
+		security shared.Security
+		want     string
+	}{
+		"service token": {
+			security: shared.Security{ServiceTokenID: "id", ServiceToken: "token"},
+			want:     "id:token",
+		},
+		"access token": {
+			security: shared.Security{AccessToken: "oauth-token"},
+			want:     "Bearer oauth-token",
+		},
+		"service token wins over access token": {
+			security: shared.Security{ServiceTokenID: "id", ServiceToken: "token", AccessToken: "oauth-token"},
+			want:     "id:token",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "https://api.planetscale.com/v1/organizations", nil)
+			require.NoError(t, err)
+
+			req, err = (&CustomSecurityHook{}).BeforeRequest(customSecurityHookContext(tc.security), req)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, req.Header.Get("Authorization"))
+		})
+	}
+}
+
+func TestCustomSecurityHookMissingCredentials(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://api.planetscale.com/v1/organizations", nil)
+	require.NoError(t, err)
+
+	_, err = (&CustomSecurityHook{}).BeforeRequest(customSecurityHookContext(shared.Security{ServiceToken: "token"}), req)
+	require.Error(t, err)
+}
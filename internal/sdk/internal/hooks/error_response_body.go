@@ -0,0 +1,63 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
+type errorResponseBodyKey struct{}
+
+// ErrorResponseBodyHook keeps the body of error responses available after
+// the SDK has handled them. Generated operations drain the body of error
+// statuses they have no model for, which would leave the provider nothing to
+// decode into a diagnostic.
+type ErrorResponseBodyHook struct{}
+
+var _ sdkInitHook = (*ErrorResponseBodyHook)(nil)
+
+func NewErrorResponseBodyHook() *ErrorResponseBodyHook {
+	return &ErrorResponseBodyHook{}
+}
+
+func (h *ErrorResponseBodyHook) SDKInit(baseURL string, client HTTPClient) (string, HTTPClient) {
+	if client == nil {
+		return baseURL, client
+	}
+
+	return baseURL, &errorResponseBodyClient{client: client}
+}
+
+type errorResponseBodyClient struct {
+	client HTTPClient
+}
+
+func (c *errorResponseBodyClient) Do(req *http.Request) (*http.Response, error) {
+	res, err := c.client.Do(req)
+	if err != nil || res == nil || res.StatusCode < 400 || res.Body == nil || res.Request == nil {
+		return res, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.Request = res.Request.WithContext(context.WithValue(res.Request.Context(), errorResponseBodyKey{}, body))
+
+	return res, nil
+}
+
+// ErrorResponseBody returns the body of an error response kept by
+// ErrorResponseBodyHook, or nil for other responses.
+func ErrorResponseBody(res *http.Response) []byte {
+	if res == nil || res.Request == nil {
+		return nil
+	}
+
+	body, _ := res.Request.Context().Value(errorResponseBodyKey{}).([]byte)
+	return body
+}
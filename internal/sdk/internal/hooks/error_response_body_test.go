@@ -0,0 +1,45 @@
+package hooks
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponseBodyHookKeepsErrorBodies(t *testing.T) {
+	hook := NewErrorResponseBodyHook()
+	_, wrappedClient := hook.SDKInit("https://api.planetscale.com", testHTTPClient(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		if strings.HasSuffix(req.URL.Path, "/missing") {
+			status = http.StatusNotFound
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"code":"not_found","message":"Not Found"}`)),
+			Request:    req,
+		}, nil
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.planetscale.com/v1/organizations/missing", nil)
+	require.NoError(t, err)
+
+	res, err := wrappedClient.Do(req)
+	require.NoError(t, err)
+
+	// Drain the body the way generated operations do.
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	require.JSONEq(t, `{"code":"not_found","message":"Not Found"}`, string(ErrorResponseBody(res)))
+
+	req, err = http.NewRequest(http.MethodGet, "https://api.planetscale.com/v1/organizations/found", nil)
+	require.NoError(t, err)
+
+	res, err = wrappedClient.Do(req)
+	require.NoError(t, err)
+	require.Nil(t, ErrorResponseBody(res))
+}
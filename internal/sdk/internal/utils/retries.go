@@ -8,8 +8,6 @@ import (
 	"fmt"
 	"github.com/planetscale/terraform-provider-planetscale/internal/sdk/retry"
 	"io"
-	"math"
-	"math/rand"
 	"net"
 	"net/http"
 	"net/url"
@@ -245,27 +243,5 @@ func (t *defaultTimer) Stop() {
 }
 
 func nextInterval(s *retry.BackoffStrategy, attempt int) time.Duration {
-	initialInterval := float64(time.Duration(s.InitialInterval) * time.Millisecond)
-	maxInterval := float64(time.Duration(s.MaxInterval) * time.Millisecond)
-	exponent := s.Exponent
-	jitterFactor := float64(0.25)
-
-	interval := initialInterval * math.Pow(float64(attempt+1), exponent)
-
-	jitter := rand.Float64() * jitterFactor * interval
-	if rand.Float64() < 0.5 {
-		jitter = -1 * jitter
-	}
-
-	interval = interval + jitter
-
-	if interval <= 0 {
-		interval = initialInterval
-	}
-
-	if interval > maxInterval {
-		interval = maxInterval
-	}
-
-	return time.Duration(interval)
+	return s.NextInterval(attempt)
 }
@@ -129,6 +129,8 @@ type GetPostgresBranchResponseBody struct {
 	ClusterSize string `json:"cluster_name"`
 	// Whether or not the branch is ready to serve queries
 	Ready bool `json:"ready"`
+	// Whether or not the branch is a production branch
+	Production bool `json:"production"`
 	// Whether deletion protection is enabled for the branch
 	DeletionProtected bool                    `json:"deletion_protected"`
 	Actor             *GetPostgresBranchActor `json:"actor"`
@@ -191,6 +193,13 @@ func (g *GetPostgresBranchResponseBody) GetReady() bool {
 	return g.Ready
 }
 
+func (g *GetPostgresBranchResponseBody) GetProduction() bool {
+	if g == nil {
+		return false
+	}
+	return g.Production
+}
+
 func (g *GetPostgresBranchResponseBody) GetDeletionProtected() bool {
 	if g == nil {
 		return false
@@ -143,6 +143,8 @@ type GetVitessBranchResponseBody struct {
 	ClusterSize string `json:"cluster_name"`
 	// Whether or not the branch is ready to serve queries
 	Ready bool `json:"ready"`
+	// Whether or not the branch is a production branch
+	Production bool `json:"production"`
 	// Whether or not the branch has safe migrations enabled
 	SafeMigrations bool `json:"safe_migrations"`
 	// Whether deletion protection is enabled for the branch
@@ -243,6 +245,13 @@ func (g *GetVitessBranchResponseBody) GetReady() bool {
 	return g.Ready
 }
 
+func (g *GetVitessBranchResponseBody) GetProduction() bool {
+	if g == nil {
+		return false
+	}
+	return g.Production
+}
+
 func (g *GetVitessBranchResponseBody) GetSafeMigrations() bool {
 	if g == nil {
 		return false
@@ -0,0 +1,18 @@
+package operations
+
+// PollingState summarizes the keyspace for polling progress. The keyspace
+// WaitForReady criteria check several flags instead of a single state field.
+func (g *GetKeyspaceResponseBody) PollingState() string {
+	switch {
+	case g.Resizing:
+		return "resizing"
+	case g.ResizePending:
+		return "resize_pending"
+	case g.ConfigChangeInProgress:
+		return "config_change_in_progress"
+	case g.Ready:
+		return "ready"
+	default:
+		return "not_ready"
+	}
+}
@@ -3,12 +3,21 @@
 package shared
 
 type Security struct {
+	// PlanetScale OAuth access token
+	AccessToken string `json:"access_token" security:"scheme,type=http,subtype=custom,name=access_token"`
 	// PlanetScale Service Token
 	ServiceToken string `json:"service_token" security:"scheme,type=http,subtype=custom,name=service_token"`
 	// PlanetScale Service Token ID
 	ServiceTokenID string `json:"service_token_id" security:"scheme,type=http,subtype=custom,name=service_token_id"`
 }
 
+func (s *Security) GetAccessToken() string {
+	if s == nil {
+		return ""
+	}
+	return s.AccessToken
+}
+
 func (s *Security) GetServiceToken() string {
 	if s == nil {
 		return ""
@@ -59,6 +59,12 @@ type PlanetScale struct {
 	//           Resources for managing postgres bouncers.
 	//
 	Bouncers *Bouncers
+	//           Resources for listing the bouncer resize requests of a branch.
+	//
+	APIBranchBouncerResizes *APIBranchBouncerResizes
+	//           Resources for managing Postgres bouncer resize requests.
+	//
+	BouncerResizes *BouncerResizes
 	//           Resources for managing cluster changes.
 	//
 	BranchChanges *BranchChanges
@@ -76,6 +82,24 @@ type PlanetScale struct {
 	//
 	Roles            *Roles
 	DatabaseBranches *DatabaseBranches
+	//             Resources for managing deploy requests.
+	//
+	DeployRequests *DeployRequests
+	//           Resources for managing schema recommendations within a database.
+	//
+	SchemaRecommendations *SchemaRecommendations
+	//             Resources for managing invoices.
+	//
+	Invoices *Invoices
+	//           Resources for managing OAuth applications.
+	//
+	OAuthApplications *OAuthApplications
+	//           API endpoints for managing service tokens within an organization.
+	//
+	ServiceTokens *ServiceTokens
+	//           Resources for managing users.
+	//
+	Users *Users
 	//           Resources for managing database backup policies.
 	//
 	BackupPolicies *BackupPolicies
@@ -85,6 +109,7 @@ type PlanetScale struct {
 
 	sdkConfiguration config.SDKConfiguration
 	hooks            *hooks.Hooks
+	defaults         Defaults
 }
 
 type SDKOption func(*PlanetScale)
@@ -183,6 +208,8 @@ func New(opts ...SDKOption) *PlanetScale {
 	sdk.Organizations = newOrganizations(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.Databases = newDatabases(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.Bouncers = newBouncers(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.APIBranchBouncerResizes = newAPIBranchBouncerResizes(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.BouncerResizes = newBouncerResizes(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.BranchChanges = newBranchChanges(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.DatabaseBranchKeyspaces = newDatabaseBranchKeyspaces(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.KeyspaceResizes = newKeyspaceResizes(sdk, sdk.sdkConfiguration, sdk.hooks)
@@ -190,6 +217,12 @@ func New(opts ...SDKOption) *PlanetScale {
 	sdk.APIBranchResizes = newAPIBranchResizes(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.Roles = newRoles(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.DatabaseBranches = newDatabaseBranches(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.DeployRequests = newDeployRequests(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.SchemaRecommendations = newSchemaRecommendations(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.Invoices = newInvoices(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.OAuthApplications = newOAuthApplications(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.ServiceTokens = newServiceTokens(sdk, sdk.sdkConfiguration, sdk.hooks)
+	sdk.Users = newUsers(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.BackupPolicies = newBackupPolicies(sdk, sdk.sdkConfiguration, sdk.hooks)
 	sdk.Backups = newBackups(sdk, sdk.sdkConfiguration, sdk.hooks)
 
@@ -0,0 +1,69 @@
+package polling
+
+import (
+	"context"
+	"errors"
+)
+
+// Override the default polling configuration backoff exponent. An exponent of
+// one polls at a fixed interval.
+func WithBackoffExponentOverride(exponent float64) Option {
+	return func(cfg *Config) error {
+		if exponent < 1 {
+			return errors.New("exponent must be greater than or equal to one")
+		}
+
+		cfg.BackoffExponent = &exponent
+
+		return nil
+	}
+}
+
+// Override the default polling configuration maximum interval seconds.
+func WithMaxIntervalSecondsOverride(maxIntervalSeconds int) Option {
+	return func(cfg *Config) error {
+		if maxIntervalSeconds <= 0 {
+			return errors.New("maxIntervalSeconds must be greater than zero")
+		}
+
+		cfg.MaxIntervalSeconds = &maxIntervalSeconds
+
+		return nil
+	}
+}
+
+// Override the default polling configuration jitter factor.
+func WithJitterFactorOverride(jitterFactor float64) Option {
+	return func(cfg *Config) error {
+		if jitterFactor < 0 || jitterFactor > 1 {
+			return errors.New("jitterFactor must be between zero and one")
+		}
+
+		cfg.JitterFactor = &jitterFactor
+
+		return nil
+	}
+}
+
+// Override the default polling configuration timeout seconds.
+func WithTimeoutSecondsOverride(timeoutSeconds int) Option {
+	return func(cfg *Config) error {
+		if timeoutSeconds <= 0 {
+			return errors.New("timeoutSeconds must be greater than zero")
+		}
+
+		cfg.TimeoutSeconds = &timeoutSeconds
+
+		return nil
+	}
+}
+
+// Register a function called after each polling attempt, for example to log
+// progress.
+func WithAttemptHook(onAttempt func(ctx context.Context, attempt Attempt)) Option {
+	return func(cfg *Config) error {
+		cfg.OnAttempt = onAttempt
+
+		return nil
+	}
+}
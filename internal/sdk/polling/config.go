@@ -1,7 +1,9 @@
-// Code generated by Speakeasy (https://speakeasy.com). DO NOT EDIT.
-
 package polling
 
+import (
+	"context"
+)
+
 // Describes the polling configuration for an operation.
 type Config struct {
 	// Number of seconds before first polling attempt.
@@ -13,6 +15,24 @@ type Config struct {
 	// Number of polling attempts before timing out.
 	LimitCount *int
 
+	// Factor applied to the interval after each attempt. Values above one
+	// back off exponentially, starting from IntervalSeconds.
+	BackoffExponent *float64
+
+	// Upper bound of the interval in seconds when backing off.
+	MaxIntervalSeconds *int
+
+	// Fraction of each interval, between zero and one, that is randomized so
+	// concurrent waits do not poll in lockstep.
+	JitterFactor *float64
+
+	// Number of seconds, including the initial delay, after which polling
+	// stops regardless of the attempt count.
+	TimeoutSeconds *int
+
+	// Called after each attempt with the observed response.
+	OnAttempt func(ctx context.Context, attempt Attempt)
+
 	// Name of the polling configuration.
 	Name string
 }
@@ -0,0 +1,180 @@
+package polling
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"time"
+)
+
+// Describes a single polling attempt.
+type Attempt struct {
+	// Name of the polling configuration.
+	Name string
+
+	// Attempt number, starting at one.
+	Number int
+
+	// HTTP status code of the response.
+	StatusCode int
+
+	// State reported by the response, such as a branch or resize state.
+	State string
+
+	// Completion percentage between 0 and 100, when the API reports one.
+	Percent *float64
+
+	// Time since polling started, including the initial delay.
+	Elapsed time.Duration
+
+	// Configured timeout, or zero when polling is only bounded by LimitCount.
+	Timeout time.Duration
+}
+
+// Error when polling reaches its configured timeout.
+type TimeoutError struct {
+	Timeout time.Duration
+
+	// State observed by the last attempt, if any.
+	State string
+}
+
+// Returns a string representation of the TimeoutError.
+func (e *TimeoutError) Error() string {
+	msg := "Polling did not complete within its configured timeout of " + e.Timeout.String()
+	if e.State != "" {
+		msg += ", last observed state was " + strconv.Quote(e.State)
+	}
+	return msg
+}
+
+// Poller tracks the attempts of a single wait and sleeps between them
+// according to its Config.
+type Poller struct {
+	config   *Config
+	started  time.Time
+	deadline time.Time
+	attempts int
+	interval time.Duration
+	state    string
+}
+
+// Creates a poller for the given configuration. The timeout, if any, starts
+// counting immediately.
+func NewPoller(config *Config) *Poller {
+	p := &Poller{
+		config:  config,
+		started: time.Now(),
+	}
+
+	if config.IntervalSeconds != nil {
+		p.interval = time.Duration(*config.IntervalSeconds) * time.Second
+	}
+
+	if config.TimeoutSeconds != nil {
+		p.deadline = p.started.Add(p.timeout())
+	}
+
+	return p
+}
+
+// Sleeps for the configured initial delay.
+func (p *Poller) Delay(ctx context.Context) error {
+	if p.config.DelaySeconds == nil {
+		return nil
+	}
+
+	return p.sleep(ctx, time.Duration(*p.config.DelaySeconds)*time.Second)
+}
+
+// Records an attempt and reports it to the configured hook.
+func (p *Poller) Observe(ctx context.Context, statusCode int, state string) {
+	p.observe(ctx, statusCode, state, nil)
+}
+
+// Records an attempt for which the API reported how far the operation has
+// progressed.
+func (p *Poller) ObserveProgress(ctx context.Context, statusCode int, state string, percent float64) {
+	p.observe(ctx, statusCode, state, &percent)
+}
+
+func (p *Poller) observe(ctx context.Context, statusCode int, state string, percent *float64) {
+	p.attempts++
+	p.state = state
+
+	if p.config.OnAttempt == nil {
+		return
+	}
+
+	p.config.OnAttempt(ctx, Attempt{
+		Name:       p.config.Name,
+		Number:     p.attempts,
+		StatusCode: statusCode,
+		State:      state,
+		Percent:    percent,
+		Elapsed:    time.Since(p.started),
+		Timeout:    p.timeout(),
+	})
+}
+
+// Sleeps until the next attempt is due. Returns a LimitCountError once the
+// configured number of attempts has been made, and a TimeoutError when the
+// next attempt would fall after the deadline.
+func (p *Poller) Wait(ctx context.Context) error {
+	if p.config.LimitCount != nil && p.attempts >= *p.config.LimitCount {
+		return &LimitCountError{Limit: *p.config.LimitCount}
+	}
+
+	wait := p.nextInterval()
+
+	if !p.deadline.IsZero() && time.Now().Add(wait).After(p.deadline) {
+		return &TimeoutError{Timeout: p.timeout(), State: p.state}
+	}
+
+	return p.sleep(ctx, wait)
+}
+
+// Returns the wait before the next attempt and advances the backoff.
+func (p *Poller) nextInterval() time.Duration {
+	wait := p.interval
+
+	if p.config.BackoffExponent != nil && *p.config.BackoffExponent > 1 {
+		next := time.Duration(float64(p.interval) * *p.config.BackoffExponent)
+		if p.config.MaxIntervalSeconds != nil {
+			next = min(next, time.Duration(*p.config.MaxIntervalSeconds)*time.Second)
+		}
+		p.interval = next
+	}
+
+	if p.config.JitterFactor != nil && *p.config.JitterFactor > 0 {
+		jitter := float64(wait) * *p.config.JitterFactor
+		wait = time.Duration(math.Max(0, float64(wait)+jitter*(2*rand.Float64()-1)))
+	}
+
+	return wait
+}
+
+func (p *Poller) timeout() time.Duration {
+	if p.config.TimeoutSeconds == nil {
+		return 0
+	}
+
+	return time.Duration(*p.config.TimeoutSeconds) * time.Second
+}
+
+func (p *Poller) sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package polling
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func TestPollerBacksOffUpToMaxInterval(t *testing.T) {
+	t.Parallel()
+
+	p := NewPoller(&Config{
+		IntervalSeconds:    intPtr(2),
+		BackoffExponent:    floatPtr(2),
+		MaxIntervalSeconds: intPtr(10),
+	})
+
+	var intervals []time.Duration
+	for i := 0; i < 5; i++ {
+		intervals = append(intervals, p.nextInterval())
+	}
+
+	require.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}, intervals)
+}
+
+func TestPollerJitter(t *testing.T) {
+	t.Parallel()
+
+	p := NewPoller(&Config{
+		IntervalSeconds: intPtr(10),
+		JitterFactor:    floatPtr(0.5),
+	})
+
+	for i := 0; i < 100; i++ {
+		wait := p.nextInterval()
+		require.GreaterOrEqual(t, wait, 5*time.Second)
+		require.LessOrEqual(t, wait, 15*time.Second)
+	}
+}
+
+func TestPollerLimitCount(t *testing.T) {
+	t.Parallel()
+
+	p := NewPoller(&Config{IntervalSeconds: intPtr(0), LimitCount: intPtr(2)})
+
+	p.Observe(context.Background(), 200, "pending")
+	require.NoError(t, p.Wait(context.Background()))
+
+	p.Observe(context.Background(), 200, "pending")
+	var limitErr *LimitCountError
+	require.True(t, errors.As(p.Wait(context.Background()), &limitErr))
+	require.Equal(t, 2, limitErr.Limit)
+}
+
+func TestPollerTimeout(t *testing.T) {
+	t.Parallel()
+
+	p := NewPoller(&Config{IntervalSeconds: intPtr(5), TimeoutSeconds: intPtr(1)})
+	p.Observe(context.Background(), 200, "resizing")
+
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(p.Wait(context.Background()), &timeoutErr))
+	require.Equal(t, time.Second, timeoutErr.Timeout)
+	require.Contains(t, timeoutErr.Error(), `"resizing"`)
+}
+
+func TestPollerWaitHonorsContext(t *testing.T) {
+	t.Parallel()
+
+	p := NewPoller(&Config{IntervalSeconds: intPtr(60)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, p.Wait(ctx), context.Canceled)
+}
+
+func TestPollerObserveCallsHook(t *testing.T) {
+	t.Parallel()
+
+	var attempts []Attempt
+	p := NewPoller(&Config{
+		Name:           "WaitForReady",
+		TimeoutSeconds: intPtr(60),
+		OnAttempt: func(_ context.Context, attempt Attempt) {
+			attempts = append(attempts, attempt)
+		},
+	})
+
+	p.Observe(context.Background(), 200, "pending")
+	p.Observe(context.Background(), 200, "ready")
+
+	require.Len(t, attempts, 2)
+	require.Equal(t, "WaitForReady", attempts[1].Name)
+	require.Equal(t, 2, attempts[1].Number)
+	require.Equal(t, "ready", attempts[1].State)
+	require.Equal(t, time.Minute, attempts[1].Timeout)
+}
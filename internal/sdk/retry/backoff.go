@@ -0,0 +1,36 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// NextInterval returns how long to wait before retrying after the given
+// attempt, counting from zero: the initial interval grows by the exponent,
+// varies by up to 25% either way and is capped at the maximum interval.
+func (s *BackoffStrategy) NextInterval(attempt int) time.Duration {
+	initialInterval := float64(time.Duration(s.InitialInterval) * time.Millisecond)
+	maxInterval := float64(time.Duration(s.MaxInterval) * time.Millisecond)
+	exponent := s.Exponent
+	jitterFactor := float64(0.25)
+
+	interval := initialInterval * math.Pow(float64(attempt+1), exponent)
+
+	jitter := rand.Float64() * jitterFactor * interval
+	if rand.Float64() < 0.5 {
+		jitter = -1 * jitter
+	}
+
+	interval = interval + jitter
+
+	if interval <= 0 {
+		interval = initialInterval
+	}
+
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	return time.Duration(interval)
+}
@@ -0,0 +1,146 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = BackupPolicyScheduleValidator{}
+
+var scheduleTimePattern = regexp.MustCompile(`^(?:[01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// BackupPolicyUnitDurations is the approximate length of each frequency and
+// retention unit, used to compare a retention period with a frequency.
+var BackupPolicyUnitDurations = map[string]time.Duration{
+	"hour":  time.Hour,
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+	"year":  365 * 24 * time.Hour,
+}
+
+type BackupPolicyScheduleValidator struct{}
+
+type backupPolicyScheduleConfig struct {
+	FrequencyUnit  types.String `tfsdk:"frequency_unit"`
+	FrequencyValue types.Int64  `tfsdk:"frequency_value"`
+	RetentionUnit  types.String `tfsdk:"retention_unit"`
+	RetentionValue types.Int64  `tfsdk:"retention_value"`
+	ScheduleDay    types.Int64  `tfsdk:"schedule_day"`
+	ScheduleTime   types.String `tfsdk:"schedule_time"`
+	ScheduleWeek   types.Int64  `tfsdk:"schedule_week"`
+}
+
+func (validator BackupPolicyScheduleValidator) Description(ctx context.Context) string {
+	return "backup policy schedule attributes must describe a schedule the API accepts"
+}
+
+func (validator BackupPolicyScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator BackupPolicyScheduleValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config backupPolicyScheduleConfig
+	for name, target := range map[string]any{
+		"frequency_unit":  &config.FrequencyUnit,
+		"frequency_value": &config.FrequencyValue,
+		"retention_unit":  &config.RetentionUnit,
+		"retention_value": &config.RetentionValue,
+		"schedule_day":    &config.ScheduleDay,
+		"schedule_time":   &config.ScheduleTime,
+		"schedule_week":   &config.ScheduleWeek,
+	} {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), target)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(config.FrequencyValue) && config.FrequencyValue.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("frequency_value"), "Invalid Backup Policy Frequency",
+			fmt.Sprintf("frequency_value must be at least 1, got: %d", config.FrequencyValue.ValueInt64()))
+	}
+	if isKnown(config.RetentionValue) && config.RetentionValue.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("retention_value"), "Invalid Backup Policy Retention",
+			fmt.Sprintf("retention_value must be at least 1, got: %d", config.RetentionValue.ValueInt64()))
+	}
+	if isKnown(config.ScheduleTime) && !scheduleTimePattern.MatchString(config.ScheduleTime.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule_time"), "Invalid Backup Policy Schedule",
+			fmt.Sprintf("schedule_time must be a time of day in HH:MM format, got: %q", config.ScheduleTime.ValueString()))
+	}
+	if isKnown(config.ScheduleDay) && (config.ScheduleDay.ValueInt64() < 0 || config.ScheduleDay.ValueInt64() > 6) {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule_day"), "Invalid Backup Policy Schedule",
+			fmt.Sprintf("schedule_day must be between 0 (Sunday) and 6 (Saturday), got: %d", config.ScheduleDay.ValueInt64()))
+	}
+	if isKnown(config.ScheduleWeek) && (config.ScheduleWeek.ValueInt64() < 0 || config.ScheduleWeek.ValueInt64() > 3) {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule_week"), "Invalid Backup Policy Schedule",
+			fmt.Sprintf("schedule_week must be between 0 (first week) and 3 (fourth week), got: %d", config.ScheduleWeek.ValueInt64()))
+	}
+
+	if isKnown(config.FrequencyUnit) {
+		unit := config.FrequencyUnit.ValueString()
+		needsDay := unit == "week" || unit == "month"
+		needsWeek := unit == "month"
+
+		switch {
+		case needsDay && config.ScheduleDay.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("schedule_day"), "Missing Backup Policy Schedule",
+				fmt.Sprintf("schedule_day is required when frequency_unit is %q.", unit))
+		case !needsDay && isKnown(config.ScheduleDay):
+			resp.Diagnostics.AddAttributeWarning(path.Root("schedule_day"), "Unused Backup Policy Schedule",
+				fmt.Sprintf("schedule_day is only used when frequency_unit is \"week\" or \"month\", and has no effect when it is %q.", unit))
+		}
+		switch {
+		case needsWeek && config.ScheduleWeek.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("schedule_week"), "Missing Backup Policy Schedule",
+				"schedule_week is required when frequency_unit is \"month\".")
+		case !needsWeek && isKnown(config.ScheduleWeek):
+			resp.Diagnostics.AddAttributeWarning(path.Root("schedule_week"), "Unused Backup Policy Schedule",
+				fmt.Sprintf("schedule_week is only used when frequency_unit is \"month\", and has no effect when it is %q.", unit))
+		}
+	}
+
+	if isKnown(config.FrequencyUnit) && isKnown(config.FrequencyValue) && isKnown(config.RetentionUnit) && isKnown(config.RetentionValue) {
+		frequency := time.Duration(config.FrequencyValue.ValueInt64()) * BackupPolicyUnitDurations[config.FrequencyUnit.ValueString()]
+		retention := time.Duration(config.RetentionValue.ValueInt64()) * BackupPolicyUnitDurations[config.RetentionUnit.ValueString()]
+		if retention < frequency {
+			resp.Diagnostics.AddAttributeError(path.Root("retention_value"), "Backup Policy Retention Shorter Than Frequency",
+				fmt.Sprintf("Backups kept for %d %s(s) expire before the next backup %d %s(s) later, leaving the branch without a backup. "+
+					"Increase the retention period or run backups more often.",
+					config.RetentionValue.ValueInt64(), config.RetentionUnit.ValueString(),
+					config.FrequencyValue.ValueInt64(), config.FrequencyUnit.ValueString()))
+		}
+	}
+}
+
+type knowable interface {
+	IsNull() bool
+	IsUnknown() bool
+}
+
+func isKnown(value knowable) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// BackupPolicySchedule returns a ConfigValidator which ensures that the
+// frequency, schedule and retention attributes of a backup policy are
+// consistent:
+//
+//   - frequency_value and retention_value are at least 1.
+//   - schedule_time is in HH:MM format.
+//   - schedule_day is 0-6 and is required for weekly and monthly policies.
+//   - schedule_week is 0-3 and is required for monthly policies.
+//   - The retention period is not shorter than the frequency.
+//
+// A schedule_day or schedule_week set on a policy that does not use it only
+// raises a warning. Checks that depend on an unknown (known after apply)
+// value are skipped.
+func BackupPolicySchedule() resource.ConfigValidator {
+	return BackupPolicyScheduleValidator{}
+}